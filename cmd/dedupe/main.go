@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/and3rson/raid/raid"
+	log "github.com/sirupsen/logrus"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only count duplicates without removing them")
+	flag.Parse()
+
+	registry := raid.MustLoadRegistry("")
+	delorean := raid.NewDelorean("history", registry, nil)
+
+	count, err := delorean.Deduplicate(context.Background(), *dryRun)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *dryRun {
+		log.Infof("main: found %d duplicate records", count)
+
+		return
+	}
+
+	log.Infof("main: removed %d duplicate records, checkpoints and intervals will be rebuilt on next start", count)
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/and3rson/raid/raid"
+	log "github.com/sirupsen/logrus"
+)
+
+const keysUsage = `Usage: raid keys <command> [flags] [ID]
+
+Commands:
+  list                 list all keys
+  add -owner <name>    issue a new key
+  update [flags] <ID>  change a key
+  enable <ID>          enable a key
+  disable <ID>         disable a key
+  delete <ID>          delete a key
+  usage [-days <N>]    show usage by keys
+
+Keys are stored in ./data/keys.sqlite, running app picks up changes immediately.
+Run "raid keys <command> -h" to see flags of a command.
+`
+
+// runKeys runs "raid keys" subcommand.
+func runKeys(args []string) {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, keysUsage)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	keyStore := raid.NewKeyStore("keys", nil)
+	registry := raid.MustLoadRegistry("")
+	command, args := args[0], args[1:]
+
+	switch command {
+	case "list":
+		list(ctx, keyStore)
+	case "add":
+		add(ctx, keyStore, registry, args)
+	case "update":
+		update(ctx, keyStore, registry, args)
+	case "enable", "disable":
+		enabled := command == "enable"
+		id := parseID(flag.NewFlagSet(command, flag.ExitOnError), args)
+
+		apiKey, err := keyStore.Update(ctx, id, raid.KeyUpdate{Enabled: &enabled})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		printKeys([]raid.APIKey{apiKey})
+	case "usage":
+		showUsage(ctx, keyStore, args)
+	case "delete":
+		id := parseID(flag.NewFlagSet(command, flag.ExitOnError), args)
+
+		if err := keyStore.Delete(ctx, id); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Infof("main: delete key %d", id)
+	default:
+		fmt.Fprint(os.Stderr, keysUsage)
+		os.Exit(2)
+	}
+}
+
+func list(ctx context.Context, keyStore *raid.KeyStore) {
+	keys, err := keyStore.List(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	printKeys(keys)
+}
+
+func showUsage(ctx context.Context, keyStore *raid.KeyStore, args []string) {
+	flags := flag.NewFlagSet("usage", flag.ExitOnError)
+	days := flags.Int("days", 7, "period in days, up to now")
+	_ = flags.Parse(args)
+
+	to := time.Now()
+
+	keys, err := raid.NewUsage("usage", keyStore).Summary(ctx, to.AddDate(0, 0, -*days), to)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOWNER\tLAST SEEN\tREQUESTS\tREJECTED\tBYTES SENT\tCONNECTION HOURS")
+
+	for _, keyUsage := range keys {
+		// Static keys from settings are not known here.
+		owner := keyUsage.Owner
+		if owner == "" {
+			owner = "-"
+		}
+
+		fmt.Fprintf(
+			w, "%d\t%s\t%s\t%d\t%d\t%d\t%.1f\n",
+			keyUsage.KeyID, owner, keyUsage.LastSeen.Format(time.RFC3339), keyUsage.Requests, keyUsage.Rejected,
+			keyUsage.BytesSent, keyUsage.ConnectionSeconds/3600,
+		)
+	}
+
+	_ = w.Flush()
+}
+
+// keyFlags are shared by add and update commands.
+type keyFlags struct {
+	owner   *string
+	expires *string
+	regions *string
+	rate    *int
+	burst   *int
+}
+
+func newKeyFlags(flags *flag.FlagSet) keyFlags {
+	return keyFlags{
+		owner:   flags.String("owner", "", "owner of the key, e.g. email"),
+		expires: flags.String("expires", "", `expiry date in RFC 3339 format, or "never"`),
+		regions: flags.String("regions", "", `comma-separated IDs of allowed states, or "all"`),
+		rate:    flags.Int("rate", 0, "allowed requests per second, 0 for default"),
+		burst:   flags.Int("burst", 0, "allowed burst of requests, 0 for same as rate"),
+	}
+}
+
+func add(ctx context.Context, keyStore *raid.KeyStore, registry *raid.Registry, args []string) {
+	flags := flag.NewFlagSet("add", flag.ExitOnError)
+	keyFlags := newKeyFlags(flags)
+	disabled := flags.Bool("disabled", false, "create the key disabled")
+	_ = flags.Parse(args)
+
+	if *keyFlags.owner == "" {
+		log.Fatal("main: -owner is required")
+	}
+
+	apiKey := raid.APIKey{
+		Owner:      *keyFlags.owner,
+		ExpiresAt:  parseExpires(*keyFlags.expires),
+		Enabled:    !*disabled,
+		Regions:    parseRegions(registry, *keyFlags.regions),
+		RatePerSec: *keyFlags.rate,
+		RateBurst:  *keyFlags.burst,
+	}
+
+	apiKey, key, err := keyStore.Create(ctx, apiKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	printKeys([]raid.APIKey{apiKey})
+	fmt.Printf("\nKey: %s\nIt cannot be shown again, only its hash is stored.\n", key)
+}
+
+func update(ctx context.Context, keyStore *raid.KeyStore, registry *raid.Registry, args []string) {
+	flags := flag.NewFlagSet("update", flag.ExitOnError)
+	keyFlags := newKeyFlags(flags)
+	id := parseID(flags, args)
+
+	keyUpdate := raid.KeyUpdate{}
+
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "owner":
+			keyUpdate.Owner = keyFlags.owner
+		case "expires":
+			keyUpdate.SetExpiresAt, keyUpdate.ExpiresAt = true, parseExpires(*keyFlags.expires)
+		case "regions":
+			regions := parseRegions(registry, *keyFlags.regions)
+			keyUpdate.Regions = &regions
+		case "rate":
+			keyUpdate.RatePerSec = keyFlags.rate
+		case "burst":
+			keyUpdate.RateBurst = keyFlags.burst
+		}
+	})
+
+	apiKey, err := keyStore.Update(ctx, id, keyUpdate)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	printKeys([]raid.APIKey{apiKey})
+}
+
+// parseID parses flags followed by key ID.
+func parseID(flags *flag.FlagSet, args []string) int {
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		log.Fatal("main: expected key ID")
+	}
+
+	id, err := strconv.Atoi(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("main: invalid key ID: %s", flags.Arg(0))
+	}
+
+	return id
+}
+
+func parseExpires(value string) *time.Time {
+	if value == "" || value == "never" {
+		return nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.Fatalf("main: invalid expiry date, expected RFC 3339 date: %s", value)
+	}
+
+	return &expiresAt
+}
+
+func parseRegions(registry *raid.Registry, value string) []int {
+	regions := []int{}
+
+	if value == "" || value == "all" {
+		return regions
+	}
+
+	snapshot := registry.NewSnapshot()
+
+	for _, idStr := range strings.Split(value, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(idStr))
+		if err != nil || snapshot.FindState(id) == nil {
+			log.Fatalf("main: unknown state ID: %s", idStr)
+		}
+
+		regions = append(regions, id)
+	}
+
+	return regions
+}
+
+func printKeys(keys []raid.APIKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOWNER\tENABLED\tCREATED\tEXPIRES\tREGIONS\tRATE\tBURST")
+
+	for _, apiKey := range keys {
+		expires := "never"
+		if apiKey.ExpiresAt != nil {
+			expires = apiKey.ExpiresAt.Format(time.RFC3339)
+		}
+
+		regions := "all"
+		if apiKey.IsRestricted() {
+			regions = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(apiKey.Regions)), ","), "[]")
+		}
+
+		fmt.Fprintf(
+			w, "%d\t%s\t%v\t%s\t%s\t%s\t%d\t%d\n",
+			apiKey.ID, apiKey.Owner, apiKey.Enabled, apiKey.CreatedAt.Format(time.RFC3339), expires, regions,
+			apiKey.RatePerSec, apiKey.RateBurst,
+		)
+	}
+
+	_ = w.Flush()
+}
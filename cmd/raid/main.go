@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/signal"
 	"sync"
@@ -13,6 +14,13 @@ import (
 )
 
 func main() {
+	// Keys are managed by a subcommand, which only needs the data directory.
+	if len(os.Args) > 1 && os.Args[1] == "keys" {
+		runKeys(os.Args[2:])
+
+		return
+	}
+
 	settings := raid.MustLoadSettings()
 
 	if settings.Debug {
@@ -27,24 +35,134 @@ func main() {
 	wg := &sync.WaitGroup{}
 	errch := make(chan error, 32)
 
-	updaterState := &raid.UpdaterState{}
+	updaterState := raid.NewUpdaterState()
 
 	persistence, err := raid.NewPersistence(updaterState, "./data/app_state.json")
+	restoreState := errors.Is(err, raid.ErrCorruptData)
+
+	if restoreState {
+		log.Errorf("main: %v, will restore app state from history", err)
+
+		persistence, err = raid.NewPersistence(updaterState, "./data/app_state.json")
+	}
+
 	if err != nil {
 		log.Fatalf("main: create app state persistence: %v", err)
 	}
 
-	updater := raid.NewUpdater(settings.TelegramChannel, settings.Timezone, settings.BacklogSize, updaterState)
-	mapGenerator := raid.NewMapGenerator(updaterState, updater.Updates)
-	delorean := raid.NewDelorean("history", updater.Updates)
-	apiServer := raid.NewAPIServer(10101, settings.APIKeys, updaterState, updater.Updates, mapGenerator.MapData, delorean.ListRecords)
-	tcpServer := raid.NewTCPServer(1024, settings.APIKeys, updaterState, updater.Updates)
+	keyStore := raid.NewKeyStore("keys", settings.APIKeys)
+
+	var (
+		source     raid.Source
+		pushSource *raid.PushSource
+	)
+
+	switch settings.Source {
+	case "telegram":
+		channelClient := raid.NewChannelClient(settings.TelegramChannel)
+		if settings.RecordDir != "" {
+			channelClient.RecordTo(settings.RecordDir)
+		}
+
+		source = channelClient
+	case "push":
+		pushSource = raid.NewPushSource(settings.PushPort, keyStore)
+		source = pushSource
+	case "replay":
+		if source, err = raid.NewReplaySource(settings.ReplayPath, settings.ReplaySpeed); err != nil {
+			log.Fatalf("main: create replay source: %v", err)
+		}
+	default:
+		log.Fatalf("main: unknown source %q", settings.Source)
+	}
+
+	registry := raid.MustLoadRegistry(settings.RegistryPath)
+	updater := raid.NewUpdater(source, registry, settings.Timezone, settings.BacklogSize, updaterState)
+	delorean := raid.NewDelorean("history", registry, updater.Updates)
+
+	if restoreState {
+		if err := delorean.RestoreState(ctx, updaterState); err != nil {
+			log.Fatalf("main: restore app state: %v", err)
+		}
+	}
+
+	mapGenerator := raid.NewMapGenerator(registry, updaterState, updater.Updates)
+	autosave := raid.NewAutosave(persistence, updaterState, updater.Updates, settings.AutosaveUpdates, settings.AutosaveInterval)
+
+	lastEventID, err := delorean.LastEventID()
+	if err != nil {
+		log.Fatalf("main: %v", err)
+	}
+
+	updater.SkipEventIDs(lastEventID)
+
+	if pushSource != nil {
+		pushSource.SkipIDs(updaterState.Snapshot().LastMessageID)
+	}
+
+	health := raid.NewHealth()
+	health.Add("delorean", true, delorean.Ping)
+	health.Add("updater", false, raid.FreshnessCheck(updaterState, settings.StaleThreshold))
+
+	usage := raid.NewUsage("usage", keyStore)
+	webhooks := raid.NewWebhooks("webhooks", keyStore, updaterState, updater.Updates, settings.StaleThreshold)
+
+	apiServer := raid.NewAPIServer(
+		10101, keyStore, settings.AdminKeys, updaterState, updater.Updates, mapGenerator, delorean, health, usage,
+		webhooks, settings.StaleThreshold,
+	)
+
+	var certReloader *raid.CertReloader
+	if settings.TLSCertFile != "" {
+		certReloader = raid.NewCertReloader(settings.TLSCertFile, settings.TLSKeyFile, settings.TLSClientCAFile)
+	}
+
+	tcpServer := raid.NewTCPServer(
+		1024, settings.TLSPort, certReloader, keyStore, usage, updaterState, updater.Updates, settings.StaleThreshold,
+	)
+	var announcer *raid.Announcer
+	if settings.AnnounceAddr != "" {
+		announcer = raid.NewAnnouncer(
+			settings.AnnounceAddr, settings.AnnounceSecret, settings.AnnounceInterval, updaterState, updater.Updates,
+			settings.StaleThreshold,
+		)
+	}
+
+	health.Add("tcp", false, tcpServer.Ping)
+	health.Add("webhooks", true, webhooks.Ping)
+
+	if certReloader != nil {
+		health.Add("tls", false, tcpServer.PingTLS)
+	}
+
+	if announcer != nil {
+		health.Add("announcer", false, announcer.Ping)
+	}
+
+	metricsServer := raid.NewMetricsServer(settings.MetricsPort, updaterState, updater.Updates)
 
 	go updater.Run(ctx, wg, errch)
+	go keyStore.Run(ctx, wg, errch)
+	go usage.Run(ctx, wg, errch)
+	go webhooks.Run(ctx, wg, errch)
 	go apiServer.Run(ctx, wg, errch)
 	go tcpServer.Run(ctx, wg, errch)
 	go mapGenerator.Run(ctx, wg, errch)
 	go delorean.Run(ctx, wg, errch)
+	go autosave.Run(ctx, wg, errch)
+	go metricsServer.Run(ctx, wg, errch)
+
+	if pushSource != nil {
+		go pushSource.Run(ctx, wg, errch)
+	}
+
+	if certReloader != nil {
+		go certReloader.Run(ctx, wg, errch)
+	}
+
+	if announcer != nil {
+		go announcer.Run(ctx, wg, errch)
+	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
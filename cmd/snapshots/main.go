@@ -8,10 +8,11 @@ import (
 )
 
 func main() {
-	updaterState := &raid.UpdaterState{}
-	raid.NewUpdater("", nil, 0, updaterState)
-	delorean := raid.NewDelorean("history", nil)
-	mapGenerator := raid.NewMapGenerator(updaterState, nil)
+	updaterState := raid.NewUpdaterState()
+	registry := raid.MustLoadRegistry("")
+	raid.NewUpdater(nil, registry, nil, 0, updaterState)
+	delorean := raid.NewDelorean("history", registry, nil)
+	mapGenerator := raid.NewMapGenerator(registry, updaterState, nil)
 
 	records, err := delorean.ListRecords()
 	if err != nil {
@@ -19,18 +20,24 @@ func main() {
 	}
 
 	for index, record := range records {
-		state := updaterState.FindState(record.StateID)
-		state.Alert = record.Alert
+		record := record
+		_ = updaterState.Update(func(data *raid.UpdaterSnapshot) error {
+			data.ApplyRecord(record)
+
+			return nil
+		})
 
 		log.Infof("main: render image %d/%d", index+1, len(records))
 
-		if err := mapGenerator.GenerateMap(updaterState, record.Date.Format("02.01.2006"), false); err != nil {
+		if err := mapGenerator.GenerateMap(updaterState.Snapshot(), record.Date.Format("02.01.2006"), false); err != nil {
 			log.Fatal(err)
 		}
 
 		filename := record.Date.Format("snapshots/2006-01-02T15_04_05.png")
 
-		if err := ioutil.WriteFile(filename, mapGenerator.MapData.Bytes, 0o644); err != nil {
+		_, data := mapGenerator.MapData.Get()
+
+		if err := ioutil.WriteFile(filename, data, 0o644); err != nil {
 			log.Fatal(err)
 		}
 	}
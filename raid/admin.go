@@ -0,0 +1,291 @@
+package raid
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/throttled/throttled/v2"
+)
+
+// CreateKeyRequest is a body of POST /admin/keys.
+type CreateKeyRequest struct {
+	Owner      string     `json:"owner"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Enabled    *bool      `json:"enabled"`
+	Regions    []int      `json:"regions"`
+	RatePerSec int        `json:"rate_per_sec"`
+	RateBurst  int        `json:"rate_burst"`
+}
+
+// CreateKeyResponse contains the key itself, which cannot be retrieved later.
+type CreateKeyResponse struct {
+	Key string `json:"key"`
+	APIKey
+}
+
+type UsageSummaryResponse struct {
+	From time.Time  `json:"from"`
+	To   time.Time  `json:"to"`
+	Keys []KeyUsage `json:"keys"`
+}
+
+// registerAdminRoutes adds API key management endpoints. They are only available if admin keys are set.
+func (a *APIServer) registerAdminRoutes(webMux *mux.Router, addrRateLimiter throttled.HTTPRateLimiter) {
+	if len(a.adminKeys) == 0 {
+		return
+	}
+
+	adminMux := webMux.PathPrefix("/admin").Subrouter()
+	adminMux.Use(addrRateLimiter.RateLimit)
+	adminMux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.Header().Add("Content-Type", "application/json")
+
+			if !a.isAdminKey(r.Header.Get("x-admin-key")) {
+				rw.WriteHeader(403)
+				enc := json.NewEncoder(rw)
+				_ = enc.Encode(map[string]string{"error": "Unknown or missing X-Admin-Key value"})
+
+				return
+			}
+			next.ServeHTTP(rw, r)
+		})
+	})
+
+	adminMux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		enc := json.NewEncoder(rw)
+
+		keys, err := a.keys.List(r.Context())
+		if err != nil {
+			log.Errorf("api: list keys: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+
+			return
+		}
+
+		rw.WriteHeader(200)
+		_ = enc.Encode(map[string][]APIKey{"keys": keys})
+	}).Methods("GET")
+
+	adminMux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		enc := json.NewEncoder(rw)
+
+		request := CreateKeyRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": fmt.Sprintf("Invalid request body: %v", err)})
+
+			return
+		}
+
+		apiKey := APIKey{
+			Owner:      request.Owner,
+			ExpiresAt:  request.ExpiresAt,
+			Enabled:    request.Enabled == nil || *request.Enabled,
+			Regions:    request.Regions,
+			RatePerSec: request.RatePerSec,
+			RateBurst:  request.RateBurst,
+		}
+
+		if err := a.validateKey(apiKey); err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		apiKey, key, err := a.keys.Create(r.Context(), apiKey)
+		if err != nil {
+			log.Errorf("api: create key: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while saving data to DB"})
+
+			return
+		}
+
+		log.Infof("api: create key %d for %s", apiKey.ID, apiKey.Owner)
+
+		rw.WriteHeader(201)
+		_ = enc.Encode(CreateKeyResponse{key, apiKey})
+	}).Methods("POST")
+
+	adminMux.HandleFunc("/keys/{id:[0-9]+}", func(rw http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+		enc := json.NewEncoder(rw)
+
+		var (
+			apiKey APIKey
+			err    error
+		)
+
+		switch r.Method {
+		case "GET":
+			apiKey, err = a.keys.Get(r.Context(), id)
+		case "PATCH":
+			var update KeyUpdate
+
+			if update, err = a.parseKeyUpdate(r); err != nil {
+				rw.WriteHeader(400)
+				_ = enc.Encode(map[string]string{"error": err.Error()})
+
+				return
+			}
+
+			apiKey, err = a.keys.Update(r.Context(), id, update)
+		case "DELETE":
+			err = a.keys.Delete(r.Context(), id)
+		}
+
+		switch {
+		case errors.Is(err, ErrKeyNotFound):
+			rw.WriteHeader(404)
+			_ = enc.Encode(map[string]string{"error": "Unknown key ID"})
+		case err != nil:
+			log.Errorf("api: %s key %d: %v", r.Method, id, err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while accessing DB"})
+		case r.Method == "DELETE":
+			log.Infof("api: delete key %d", id)
+			rw.WriteHeader(204)
+		default:
+			if r.Method == "PATCH" {
+				log.Infof("api: update key %d", id)
+			}
+
+			rw.WriteHeader(200)
+			_ = enc.Encode(apiKey)
+		}
+	}).Methods("GET", "PATCH", "DELETE")
+
+	adminMux.HandleFunc("/usage", func(rw http.ResponseWriter, r *http.Request) {
+		enc := json.NewEncoder(rw)
+
+		from, to, err := parseUsagePeriod(r.URL.Query())
+		if err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		keys, err := a.usage.Summary(r.Context(), from, to)
+		if err != nil {
+			log.Errorf("api: get usage summary: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+
+			return
+		}
+
+		rw.WriteHeader(200)
+		_ = enc.Encode(UsageSummaryResponse{from, to, keys})
+	}).Methods("GET")
+}
+
+func (a *APIServer) isAdminKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for _, adminKey := range a.adminKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(adminKey)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *APIServer) validateKey(apiKey APIKey) error {
+	if apiKey.Owner == "" {
+		return errors.New("owner is required")
+	}
+
+	if apiKey.RatePerSec < 0 || apiKey.RateBurst < 0 {
+		return errors.New("rate quota cannot be negative")
+	}
+
+	snapshot := a.updaterState.Snapshot()
+
+	for _, id := range apiKey.Regions {
+		if snapshot.FindState(id) == nil {
+			return fmt.Errorf("unknown state ID in regions: %d", id)
+		}
+	}
+
+	return nil
+}
+
+// parseKeyUpdate decodes PATCH body, where omitted fields are left unchanged and "expires_at": null removes expiry.
+func (a *APIServer) parseKeyUpdate(r *http.Request) (KeyUpdate, error) {
+	update := KeyUpdate{}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		return update, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	for name, value := range fields {
+		if string(value) == "null" && name != "expires_at" && name != "regions" {
+			return update, fmt.Errorf("%s cannot be null", name)
+		}
+
+		var target interface{}
+
+		switch name {
+		case "owner":
+			update.Owner = new(string)
+			target = update.Owner
+		case "enabled":
+			update.Enabled = new(bool)
+			target = update.Enabled
+		case "expires_at":
+			update.SetExpiresAt = true
+			target = &update.ExpiresAt
+		case "regions":
+			update.Regions = &[]int{}
+			target = update.Regions
+		case "rate_per_sec":
+			update.RatePerSec = new(int)
+			target = update.RatePerSec
+		case "rate_burst":
+			update.RateBurst = new(int)
+			target = update.RateBurst
+		default:
+			return update, fmt.Errorf("unknown field: %s", name)
+		}
+
+		if err := json.Unmarshal(value, target); err != nil {
+			return update, fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	// Validate resulting values of changed fields only.
+	apiKey := APIKey{Owner: "-"}
+
+	if update.Owner != nil {
+		apiKey.Owner = *update.Owner
+	}
+
+	if update.Regions != nil {
+		apiKey.Regions = *update.Regions
+	}
+
+	if update.RatePerSec != nil {
+		apiKey.RatePerSec = *update.RatePerSec
+	}
+
+	if update.RateBurst != nil {
+		apiKey.RateBurst = *update.RateBurst
+	}
+
+	return update, a.validateKey(apiKey)
+}
@@ -0,0 +1,141 @@
+package raid
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/and3rson/raid/raid/binproto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Announcer is not healthy if full state could not be announced this many times in a row.
+const announcerMaxFailures = 3
+
+// Announcer sends updates to a UDP multicast group or broadcast address in signed binary datagrams,
+// so that an on-site relay can serve any number of receivers in a local network without API keys.
+// Full state is announced periodically, since datagrams may be lost.
+type Announcer struct {
+	addr     string
+	secret   []byte
+	interval time.Duration
+	// Sequence number of the next datagram.
+	sequence       uint32
+	updaterState   *UpdaterState
+	updates        *Topic[Update]
+	staleThreshold time.Duration
+	// Marked on every datagram which was sent successfully.
+	sent activity
+}
+
+func NewAnnouncer(
+	addr string, secret string, interval time.Duration, updaterState *UpdaterState, updates *Topic[Update],
+	staleThreshold time.Duration,
+) *Announcer {
+	return &Announcer{
+		addr:           addr,
+		secret:         []byte(secret),
+		interval:       interval,
+		updaterState:   updaterState,
+		updates:        updates,
+		staleThreshold: staleThreshold,
+	}
+}
+
+func (a *Announcer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("announcer: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	// Multicast datagrams are sent with TTL of 1 by default, so they don't leave the local network.
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", a.addr)
+	if err != nil {
+		errch <- fmt.Errorf("announcer: dial: %w", err)
+
+		return
+	}
+	defer conn.Close()
+
+	events := a.updates.Subscribe("announcer", OverflowBlock, func(u Update) bool {
+		return u.IsFresh
+	})
+	defer a.updates.Unsubscribe(events)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	log.Infof("announcer: announce to %s every %s", a.addr, a.interval)
+
+	a.send(conn, a.snapshotFrames())
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			a.send(conn, []binproto.Frame{updateFrame(event)})
+		case <-ticker.C:
+			a.send(conn, a.snapshotFrames())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Ping fails if datagrams could not be sent for several intervals, e.g. if network is down.
+func (a *Announcer) Ping(ctx context.Context) error {
+	if err := a.sent.check("sent datagram", announcerMaxFailures*a.interval); err != nil {
+		return fmt.Errorf("announcer: %w", err)
+	}
+
+	return nil
+}
+
+// snapshotFrames returns state bitmaps of all alert types followed by ping.
+func (a *Announcer) snapshotFrames() []binproto.Frame {
+	snapshot := a.updaterState.Snapshot()
+	frames := []binproto.Frame{}
+
+	for _, frame := range binaryBitmaps(snapshot, func(int) bool { return true }) {
+		frames = append(frames, frame)
+	}
+
+	return append(frames, binproto.Ping{
+		Time:     uint32(time.Now().Unix()),
+		Degraded: snapshot.Stale(a.staleThreshold),
+	})
+}
+
+func updateFrame(u Update) binproto.Change {
+	if u.District != nil {
+		return binaryChange(u.EventID, u.District.ID, u.AlertType, u.District.HasAlert(u.AlertType), u.District.Changed)
+	}
+
+	return binaryChange(u.EventID, u.State.ID, u.AlertType, u.State.HasAlert(u.AlertType), u.State.Changed)
+}
+
+// send writes a datagram. Errors are only logged, since network may be temporarily unavailable.
+func (a *Announcer) send(conn net.Conn, frames []binproto.Frame) {
+	data := binproto.EncodeDatagram(a.secret, binproto.Datagram{
+		Time:     uint32(time.Now().Unix()),
+		Sequence: a.sequence,
+		Frames:   frames,
+	})
+	a.sequence++
+
+	if _, err := conn.Write(data); err != nil {
+		announcedDatagrams.WithLabelValues("error").Inc()
+		log.Errorf("announcer: send: %v", err)
+
+		return
+	}
+
+	a.sent.mark()
+	announcedDatagrams.WithLabelValues("sent").Inc()
+	log.Tracef("announcer: send %d frames, %d bytes", len(frames), len(data))
+}
@@ -3,11 +3,13 @@ package raid
 import (
 	"context"
 	"embed"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,6 +38,7 @@ var indexEnContent []byte
 type StatesResponse struct {
 	States     []State   `json:"states"`
 	LastUpdate time.Time `json:"last_update"`
+	Degraded   bool      `json:"degraded"`
 }
 
 type ShortState struct {
@@ -46,31 +49,99 @@ type ShortState struct {
 type StatesShortResponse struct {
 	States     []ShortState `json:"states"`
 	LastUpdate time.Time    `json:"last_update"`
+	Degraded   bool         `json:"degraded"`
 }
 
 type StateResponse struct {
 	*State     `json:"state"`
 	LastUpdate time.Time `json:"last_update"`
+	Degraded   bool      `json:"degraded"`
+}
+
+type DistrictsResponse struct {
+	Districts  []District `json:"districts"`
+	LastUpdate time.Time  `json:"last_update"`
+	Degraded   bool       `json:"degraded"`
 }
 
 type PollResponse struct {
+	EventID        int64     `json:"event_id"`
 	State          State     `json:"state"`
+	District       *District `json:"district,omitempty"`
+	AlertType      AlertType `json:"alert_type"`
 	NotificationID uuid.UUID `json:"notification_id"`
+	Degraded       bool      `json:"degraded"`
+}
+
+// StatusResponse is sent in SSE status events to let clients know if the data may be stale.
+type StatusResponse struct {
+	Degraded bool `json:"degraded"`
+}
+
+// Namespace for notification IDs, which are derived from event IDs to be the same for all clients.
+var notificationNamespace = uuid.MustParse("9a3ec8e4-4f0b-4b4e-8f5e-6d1b7b0c2a10")
+
+func NewPollResponse(update Update) PollResponse {
+	return PollResponse{
+		update.EventID,
+		update.State.WithoutDistricts(),
+		update.District,
+		update.AlertType,
+		uuid.NewSHA1(notificationNamespace, []byte(strconv.FormatInt(update.EventID, 10))),
+		false,
+	}
+}
+
+// HistoryStore provides access to recorded alerts, it is implemented by Delorean.
+type HistoryStore interface {
+	QueryRecords(ctx context.Context, query RecordQuery, fn func(Record) error) error
+	StateAt(ctx context.Context, at time.Time) (*UpdaterSnapshot, error)
+	Stats(ctx context.Context, query StatsQuery) ([]RegionStats, error)
+}
+
+type UsageResponse struct {
+	From   time.Time     `json:"from"`
+	To     time.Time     `json:"to"`
+	Totals UsageCounters `json:"totals"`
+	Usage  []UsageRecord `json:"usage"`
+}
+
+type StatsResponse struct {
+	From    time.Time     `json:"from"`
+	To      time.Time     `json:"to"`
+	Regions []RegionStats `json:"regions"`
 }
 
 type APIServer struct {
 	port              uint16
-	apiKeys           []string
-	apiKeysMap        map[string]bool
+	keys              *KeyStore
+	adminKeys         []string
 	updaterState      *UpdaterState
 	updates           *Topic[Update]
-	mapData           *MapData
-	listRecordsFunc   func() ([]Record, error)
+	mapGenerator      *MapGenerator
+	history           HistoryStore
+	health            *Health
+	usage             *Usage
+	webhooks          *Webhooks
+	staleThreshold    time.Duration
 	addrRateLimiter   throttled.RateLimiter
 	apiKeyRateLimiter throttled.RateLimiter
-	staticDirFS       fs.FS
+	// Rate limiters of keys with custom quotas by key IDs.
+	keyRateLimiters      map[int]keyRateLimiter
+	keyRateLimitersMutex sync.Mutex
+	staticDirFS          fs.FS
+}
+
+type keyRateLimiter struct {
+	perSec int
+	burst  int
+	throttled.RateLimiter
 }
 
+type contextKey int
+
+const apiKeyContextKey contextKey = iota
+
 func CreateRateLimiter(perSec int, burst int) throttled.RateLimiter {
 	store, err := memstore.New(16384)
 	if err != nil {
@@ -89,14 +160,10 @@ func CreateRateLimiter(perSec int, burst int) throttled.RateLimiter {
 }
 
 func NewAPIServer(
-	port uint16, apiKeys []string, updaterState *UpdaterState, updates *Topic[Update], mapData *MapData,
-	listRecordsFunc func() ([]Record, error),
+	port uint16, keys *KeyStore, adminKeys []string, updaterState *UpdaterState, updates *Topic[Update],
+	mapGenerator *MapGenerator, history HistoryStore, health *Health, usage *Usage, webhooks *Webhooks,
+	staleThreshold time.Duration,
 ) *APIServer {
-	apiKeysMap := make(map[string]bool)
-	for _, key := range apiKeys {
-		apiKeysMap[key] = true
-	}
-
 	staticDirFS, err := fs.Sub(staticFS, "static")
 	if err != nil {
 		log.Fatalf("api: create sub fs: %v", err)
@@ -104,23 +171,28 @@ func NewAPIServer(
 
 	return &APIServer{
 		port:              port,
-		apiKeys:           apiKeys,
-		apiKeysMap:        apiKeysMap,
+		keys:              keys,
+		adminKeys:         adminKeys,
 		updaterState:      updaterState,
 		updates:           updates,
-		mapData:           mapData,
-		listRecordsFunc:   listRecordsFunc,
+		mapGenerator:      mapGenerator,
+		history:           history,
+		health:            health,
+		usage:             usage,
+		webhooks:          webhooks,
+		staleThreshold:    staleThreshold,
 		addrRateLimiter:   CreateRateLimiter(10, 10),
 		apiKeyRateLimiter: CreateRateLimiter(100, 100),
+		keyRateLimiters:   map[int]keyRateLimiter{},
 		staticDirFS:       staticDirFS,
 	}
 }
 
 func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 	webMux := mux.NewRouter()
-	apiMux := webMux.PathPrefix("/api").Subrouter()
 	httpAPIKeyRateLimiter := throttled.HTTPRateLimiter{
 		DeniedHandler: http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rateLimitRejections.WithLabelValues("api_key").Inc()
 			rw.WriteHeader(429)
 			enc := json.NewEncoder(rw)
 			_ = enc.Encode(map[string]string{"error": "Too many requests using your API key"})
@@ -132,6 +204,7 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 	}
 	httpAddrRateLimiter := throttled.HTTPRateLimiter{
 		DeniedHandler: http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rateLimitRejections.WithLabelValues("address").Inc()
 			rw.WriteHeader(429)
 			enc := json.NewEncoder(rw)
 			_ = enc.Encode(map[string]string{"error": "Too many requests from your address"})
@@ -155,17 +228,23 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 		},
 	}
 
+	webMux.HandleFunc("/healthz", a.health.Handler(false))
+	webMux.HandleFunc("/readyz", a.health.Handler(true))
+
+	// WebSocket clients authenticate after connecting since browsers cannot send custom headers.
+	webMux.Handle("/api/ws", httpAddrRateLimiter.RateLimit(a.handleWebSocket(ctx)))
+
+	apiMux := webMux.PathPrefix("/api").Subrouter()
 	apiMux.Use(handlers.CORS(
-		handlers.AllowedHeaders([]string{"X-API-Key", "Content-Type", "Cache-Control"}),
-		handlers.AllowedMethods([]string{"GET", "HEAD", "OPTIONS"}),
+		handlers.AllowedHeaders([]string{"X-API-Key", "Content-Type", "Cache-Control", "Last-Event-ID"}),
+		handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "DELETE", "OPTIONS"}),
 		handlers.AllowedOrigins([]string{"*"}),
 	))
-	apiMux.Use(httpAPIKeyRateLimiter.RateLimit)
 	apiMux.Use(httpAddrRateLimiter.RateLimit)
 	apiMux.Use(func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-			key := r.Header.Get("x-api-key")
-			if _, ok := a.apiKeysMap[key]; !ok {
+			apiKey := a.keys.Lookup(r.Header.Get("x-api-key"))
+			if apiKey == nil {
 				rw.Header().Add("Content-Type", "application/json")
 				rw.WriteHeader(403)
 				enc := json.NewEncoder(rw)
@@ -173,7 +252,16 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 
 				return
 			}
-			next.ServeHTTP(rw, r)
+			next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey, apiKey)))
+		})
+	})
+	apiMux.Use(a.usage.Middleware)
+	// Keys are rate limited after authentication since their quotas may differ.
+	apiMux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rateLimiter := httpAPIKeyRateLimiter
+			rateLimiter.RateLimiter = a.keyRateLimiter(requestAPIKey(r))
+			rateLimiter.RateLimit(next).ServeHTTP(rw, r)
 		})
 	})
 
@@ -186,15 +274,37 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 		short := r.URL.Query().Has("short")
 
 		rw.Header().Add("Content-Type", "application/json")
-		rw.WriteHeader(200)
 		enc := json.NewEncoder(rw)
 
+		snapshot, status, err := a.snapshotAt(r)
+		if err != nil {
+			rw.WriteHeader(status)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		apiKey := requestAPIKey(r)
+		if id != 0 && !apiKey.Allows(id) {
+			rw.WriteHeader(403)
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+			return
+		}
+
+		// Past states are never degraded.
+		degraded := !r.URL.Query().Has("at") && a.isDegraded(snapshot)
+
+		rw.WriteHeader(200)
+
 		if id != 0 {
-			for i, state := range a.updaterState.States {
+			for _, state := range snapshot.States {
 				if state.ID == id {
+					state := state.WithoutDistricts()
 					_ = enc.Encode(StateResponse{
-						&a.updaterState.States[i],
-						a.updaterState.LastUpdate,
+						&state,
+						snapshot.LastUpdate,
+						degraded,
 					})
 
 					return
@@ -203,22 +313,35 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 
 			_ = enc.Encode(StateResponse{
 				nil,
-				a.updaterState.LastUpdate,
+				snapshot.LastUpdate,
+				degraded,
 			})
 		} else {
 			if short {
 				shortStates := []ShortState{}
-				for _, state := range a.updaterState.States {
+				for _, state := range snapshot.States {
+					if !apiKey.Allows(state.ID) {
+						continue
+					}
 					shortStates = append(shortStates, ShortState{ID: state.ID, Alert: state.Alert})
 				}
 				_ = enc.Encode(StatesShortResponse{
 					shortStates,
-					a.updaterState.LastUpdate,
+					snapshot.LastUpdate,
+					degraded,
 				})
 			} else {
+				states := []State{}
+				for _, state := range snapshot.States {
+					if !apiKey.Allows(state.ID) {
+						continue
+					}
+					states = append(states, state.WithoutDistricts())
+				}
 				_ = enc.Encode(StatesResponse{
-					a.updaterState.States,
-					a.updaterState.LastUpdate,
+					states,
+					snapshot.LastUpdate,
+					degraded,
 				})
 			}
 		}
@@ -226,25 +349,97 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 	apiMux.HandleFunc("/states", statesHandleFunc)
 	apiMux.HandleFunc("/states/{id:[0-9]+}", statesHandleFunc)
 
+	apiMux.HandleFunc("/states/{id:[0-9]+}/districts", func(rw http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		snapshot := a.updaterState.Snapshot()
+
+		state := snapshot.FindState(id)
+		if state == nil {
+			rw.WriteHeader(404)
+			_ = enc.Encode(map[string]string{"error": "Unknown state ID"})
+
+			return
+		}
+
+		if !requestAPIKey(r).Allows(id) {
+			rw.WriteHeader(403)
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+			return
+		}
+
+		rw.WriteHeader(200)
+		_ = enc.Encode(DistrictsResponse{
+			state.Districts,
+			snapshot.LastUpdate,
+			a.isDegraded(snapshot),
+		})
+	})
+
 	liveHandleFunc := func(rw http.ResponseWriter, r *http.Request) {
 		id := 0
 		if idStr, ok := mux.Vars(r)["id"]; ok {
 			id, _ = strconv.Atoi(idStr)
 		}
 
-		if id == 0 {
-			log.Info("api: subscribe to events")
-		} else {
+		districtID := 0
+
+		if districtIDStr := r.URL.Query().Get("district"); districtIDStr != "" {
+			districtID, _ = strconv.Atoi(districtIDStr)
+
+			state, _ := a.updaterState.Snapshot().FindDistrict(districtID)
+			if state == nil || (id != 0 && id != state.ID) {
+				rw.Header().Add("Content-Type", "application/json")
+				rw.WriteHeader(404)
+				enc := json.NewEncoder(rw)
+				_ = enc.Encode(map[string]string{"error": "Unknown district ID"})
+
+				return
+			}
+
+			id = state.ID
+		}
+
+		apiKey := requestAPIKey(r)
+		if id != 0 && !apiKey.Allows(id) {
+			rw.Header().Add("Content-Type", "application/json")
+			rw.WriteHeader(403)
+			enc := json.NewEncoder(rw)
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+			return
+		}
+
+		switch {
+		case districtID != 0:
+			log.Infof("api: subscribe to events for district %d", districtID)
+		case id != 0:
 			log.Infof("api: subscribe to events for state %d", id)
+		default:
+			log.Info("api: subscribe to events")
 		}
 
-		events := a.updates.Subscribe("api-"+r.RemoteAddr, func(u Update) bool {
-			return u.IsFresh && (id == 0 || id == u.State.ID)
+		lastEventID, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+
+		// When filtering by district, state-level updates of the parent state are delivered too
+		// since they affect the whole state including the district.
+		events, missed := a.updates.SubscribeWithHistory("api-"+r.RemoteAddr, OverflowDisconnect, func(u Update) bool {
+			return u.IsFresh && (id == 0 || id == u.State.ID) && apiKey.Allows(u.State.ID) &&
+				(districtID == 0 || u.District == nil || u.District.ID == districtID)
+		}, func(u Update) bool {
+			return lastEventID != 0 && u.EventID > lastEventID
 		})
 		defer func() {
 			log.Infof("api: unsubscribe from events")
 			a.updates.Unsubscribe(events)
 		}()
+
+		connection := a.usage.Connect(apiKey.Hash, routeEndpoint(r))
+		defer a.usage.Disconnect(connection)
 		rw.Header().Set("Content-Type", "text/event-stream")
 		rw.Header().Set("Cache-Control", "no-cache")
 		sse := NewSSEEncoder(rw)
@@ -253,6 +448,46 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 			log.Errorf("api: send SSE hello: %s", err)
 		}
 
+		if lastEventID != 0 && !a.canResume(lastEventID) {
+			log.Infof("api: cannot resume from event %d", lastEventID)
+
+			if err := sse.Write("reset", nil); err != nil {
+				log.Errorf("api: send SSE reset: %s", err)
+
+				return
+			}
+		}
+
+		for _, event := range missed {
+			if err := sse.WriteWithID(strconv.FormatInt(event.EventID, 10), "update", a.newPollResponse(event)); err != nil {
+				log.Errorf("api: send SSE missed update: %s", err)
+
+				return
+			}
+		}
+
+		// Status is sent on connect and then only when it changes, ping payload stays null for old clients.
+		var degraded *bool
+
+		writeStatus := func() error {
+			current := a.isDegraded(a.updaterState.Snapshot())
+			if degraded != nil && *degraded == current {
+				return nil
+			}
+
+			degraded = &current
+
+			return sse.Write("status", StatusResponse{current})
+		}
+
+		if err := writeStatus(); err != nil {
+			log.Errorf("api: send SSE status: %s", err)
+
+			return
+		}
+
+		keysChanged := a.keys.Changed()
+
 		for {
 			select {
 			case event, ok := <-events:
@@ -260,22 +495,37 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 					return
 				}
 
-				uuid1, err := uuid.NewUUID()
-				if err != nil {
-					return
-				}
-
-				if err := sse.Write("update", PollResponse{event.State, uuid1}); err != nil {
+				if err := sse.WriteWithID(strconv.FormatInt(event.EventID, 10), "update", a.newPollResponse(event)); err != nil {
 					log.Errorf("api: send SSE update: %s", err)
 
 					return
 				}
 			case <-time.After(5 * time.Second):
+				if !a.keys.IsCurrent(r.Header.Get("x-api-key"), apiKey) {
+					log.Info("api: API key was changed, disconnect")
+
+					return
+				}
+
 				if err := sse.Write("ping", nil); err != nil {
 					log.Errorf("api: send SSE ping: %s", err)
 
 					return
 				}
+
+				if err := writeStatus(); err != nil {
+					log.Errorf("api: send SSE status: %s", err)
+
+					return
+				}
+			case <-keysChanged:
+				if !a.keys.IsCurrent(r.Header.Get("x-api-key"), apiKey) {
+					log.Info("api: API key was changed, disconnect")
+
+					return
+				}
+
+				keysChanged = a.keys.Changed()
 			case <-ctx.Done():
 				return
 			}
@@ -284,42 +534,186 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 	apiMux.HandleFunc("/states/live", liveHandleFunc)
 	apiMux.HandleFunc("/states/live/{id:[0-9]+}", liveHandleFunc)
 
-	const historyCooldown = 60 * time.Second
+	apiMux.HandleFunc("/history", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
 
-	historyCallsPerKey := make(map[string]time.Time)
+		query, err := parseRecordQuery(r.URL.Query())
+		if err != nil {
+			rw.WriteHeader(400)
+			enc := json.NewEncoder(rw)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
 
-	apiMux.HandleFunc("/history", func(rw http.ResponseWriter, r *http.Request) {
-		key := r.Header.Get("x-api-key")
-		lastCall := historyCallsPerKey[key]
-		if time.Since(lastCall) < historyCooldown {
-			rw.Header().Add("Content-Type", "application/json")
-			rw.WriteHeader(429)
+			return
+		}
+
+		var allowed bool
+		if query.StateIDs, allowed = restrictStateIDs(requestAPIKey(r), query.StateID); !allowed {
+			rw.WriteHeader(403)
 			enc := json.NewEncoder(rw)
-			_ = enc.Encode(map[string]string{
-				"error": fmt.Sprintf("Please wait %d seconds", int(time.Until(lastCall.Add(historyCooldown)).Seconds())),
-			})
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+			return
+		}
+
+		// Records are streamed as they are read from DB, so the response is assembled manually:
+		// {"records":[...],"next_cursor":"..."}
+		count, lastID := 0, 0
+		enc := json.NewEncoder(rw)
+
+		if err := a.history.QueryRecords(r.Context(), query, func(record Record) error {
+			prefix := ","
+			if count == 0 {
+				rw.WriteHeader(200)
+
+				prefix = `{"records":[`
+			}
+
+			if _, err := rw.Write([]byte(prefix)); err != nil {
+				return fmt.Errorf("api: write records: %w", err)
+			}
+
+			if err := enc.Encode(record); err != nil {
+				return fmt.Errorf("api: encode record: %w", err)
+			}
+
+			count++
+			lastID = record.ID
+
+			return nil
+		}); err != nil {
+			log.Errorf("api: query records: %v", err)
+
+			if count == 0 {
+				rw.WriteHeader(500)
+				_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+			}
+
+			// Otherwise the response is left truncated and hence invalid.
+			return
+		}
+
+		if count == 0 {
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"records":[`))
+		}
+
+		var nextCursor *string
+
+		if count == query.Limit {
+			cursor := encodeHistoryCursor(lastID)
+			nextCursor = &cursor
+		}
+
+		_, _ = rw.Write([]byte(`],"next_cursor":`))
+		_ = enc.Encode(nextCursor)
+		_, _ = rw.Write([]byte(`}`))
+	})
+
+	apiMux.HandleFunc("/stats", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		query, err := parseStatsQuery(r.URL.Query())
+		if err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		var allowed bool
+		if query.StateIDs, allowed = restrictStateIDs(requestAPIKey(r), query.StateID); !allowed {
+			rw.WriteHeader(403)
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
 
 			return
 		}
-		historyCallsPerKey[key] = time.Now()
 
-		records, err := a.listRecordsFunc()
+		regions, err := a.history.Stats(r.Context(), query)
 		if err != nil {
-			log.Errorf("api: list records: %v", err)
-			rw.Header().Add("Content-Type", "text/html; charset=utf-8")
+			log.Errorf("api: get stats: %v", err)
 			rw.WriteHeader(500)
-			enc := json.NewEncoder(rw)
 			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
 
 			return
 		}
 
-		rw.Header().Add("Content-Type", "text/html; charset=utf-8")
 		rw.WriteHeader(200)
+		_ = enc.Encode(StatsResponse{query.From, query.To, regions})
+	})
+
+	apiMux.HandleFunc("/me/usage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		from, to, err := parseUsagePeriod(r.URL.Query())
+		if err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		records, err := a.usage.Query(r.Context(), requestAPIKey(r).Hash, from, to)
+		if err != nil {
+			log.Errorf("api: get usage: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+
+			return
+		}
+
+		totals := UsageCounters{}
+		for _, record := range records {
+			totals.add(record.UsageCounters)
+		}
+
+		rw.WriteHeader(200)
+		_ = enc.Encode(UsageResponse{from, to, totals, records})
+	})
+
+	apiMux.HandleFunc("/map.png", func(rw http.ResponseWriter, r *http.Request) {
+		if !r.URL.Query().Has("at") {
+			a.writeCurrentMap(rw)
+
+			return
+		}
+
+		rw.Header().Add("Content-Type", "application/json")
 		enc := json.NewEncoder(rw)
-		_ = enc.Encode(records)
+
+		// Past map shows all states, and history of other states is not available to restricted keys.
+		if requestAPIKey(r).IsRestricted() {
+			rw.WriteHeader(403)
+			_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+			return
+		}
+
+		snapshot, status, err := a.snapshotAt(r)
+		if err != nil {
+			rw.WriteHeader(status)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		data, err := a.mapGenerator.RenderMap(snapshot, "", true)
+		if err != nil {
+			log.Errorf("api: render map: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while rendering map"})
+
+			return
+		}
+
+		rw.Header().Set("Content-Type", "image/png")
+		rw.WriteHeader(200)
+		_, _ = rw.Write(data)
 	})
 
+	a.registerWebhookRoutes(apiMux)
+
 	webMux.HandleFunc("/", func(rw http.ResponseWriter, r *http.Request) {
 		rw.Header().Add("Content-Type", "text/html; charset=utf-8")
 		rw.WriteHeader(200)
@@ -335,16 +729,274 @@ func (a *APIServer) CreateRouter(ctx context.Context) *mux.Router {
 		rw.WriteHeader(200)
 		_, _ = rw.Write(indexEnContent)
 	})
-	webMux.HandleFunc("/map.png", func(rw http.ResponseWriter, r *http.Request) {
-		rw.Header().Add("Content-Type", a.mapData.ContentType)
-		rw.WriteHeader(200)
-		_, _ = rw.Write(a.mapData.Bytes)
-	})
+	// Only the cached current map is public, past maps are rendered on demand and require an API key.
+	webMux.Handle("/map.png", httpAddrRateLimiter.RateLimit(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("at") {
+			rw.Header().Add("Content-Type", "text/plain; charset=utf-8")
+			rw.WriteHeader(400)
+			_, _ = rw.Write([]byte("Maps in the past are only available at /api/map.png with X-API-Key"))
+
+			return
+		}
+
+		a.writeCurrentMap(rw)
+	})))
+	a.registerAdminRoutes(webMux, httpAddrRateLimiter)
+
 	webMux.PathPrefix("/").Handler(http.FileServer(http.FS(a.staticDirFS)))
 
 	return webMux
 }
 
+const (
+	historyDefaultLimit = 1000
+	historyMaxLimit     = 10000
+)
+
+func parseRecordQuery(values url.Values) (RecordQuery, error) {
+	query := RecordQuery{Limit: historyDefaultLimit}
+
+	var err error
+
+	if value := values.Get("state_id"); value != "" {
+		if query.StateID, err = strconv.Atoi(value); err != nil {
+			return query, fmt.Errorf("invalid state_id: %s", value)
+		}
+	}
+
+	if value := values.Get("from"); value != "" {
+		if query.From, err = time.Parse(time.RFC3339, value); err != nil {
+			return query, fmt.Errorf("invalid from, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	if value := values.Get("to"); value != "" {
+		if query.To, err = time.Parse(time.RFC3339, value); err != nil {
+			return query, fmt.Errorf("invalid to, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	if value := values.Get("alert"); value != "" {
+		alert, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid alert, expected true or false: %s", value)
+		}
+
+		query.Alert = &alert
+	}
+
+	if value := values.Get("alert_type"); value != "" {
+		if query.AlertType, err = ParseAlertType(value); err != nil {
+			return query, fmt.Errorf("invalid alert_type: %s", value)
+		}
+	}
+
+	if value := values.Get("limit"); value != "" {
+		if query.Limit, err = strconv.Atoi(value); err != nil || query.Limit <= 0 || query.Limit > historyMaxLimit {
+			return query, fmt.Errorf("invalid limit, expected number from 1 to %d: %s", historyMaxLimit, value)
+		}
+	}
+
+	if value := values.Get("cursor"); value != "" {
+		if query.AfterID, err = decodeHistoryCursor(value); err != nil {
+			return query, fmt.Errorf("invalid cursor: %s", value)
+		}
+	}
+
+	return query, nil
+}
+
+// Default period of stats if "from" is not set.
+const statsDefaultPeriod = 30 * 24 * time.Hour
+
+func parseStatsQuery(values url.Values) (StatsQuery, error) {
+	query := StatsQuery{To: time.Now()}
+
+	var err error
+
+	if value := values.Get("to"); value != "" {
+		if query.To, err = time.Parse(time.RFC3339, value); err != nil {
+			return query, fmt.Errorf("invalid to, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	query.From = query.To.Add(-statsDefaultPeriod)
+
+	if value := values.Get("from"); value != "" {
+		if query.From, err = time.Parse(time.RFC3339, value); err != nil {
+			return query, fmt.Errorf("invalid from, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	if !query.From.Before(query.To) {
+		return query, errors.New("invalid period, from must be before to")
+	}
+
+	if value := values.Get("state_id"); value != "" {
+		if query.StateID, err = strconv.Atoi(value); err != nil {
+			return query, fmt.Errorf("invalid state_id: %s", value)
+		}
+	}
+
+	if value := values.Get("alert_type"); value != "" {
+		if query.AlertType, err = ParseAlertType(value); err != nil {
+			return query, fmt.Errorf("invalid alert_type: %s", value)
+		}
+	}
+
+	return query, nil
+}
+
+const (
+	// Default period of usage if "from" is not set.
+	usageDefaultPeriod = 7 * 24 * time.Hour
+	usageMaxPeriod     = 31 * 24 * time.Hour
+)
+
+func parseUsagePeriod(values url.Values) (time.Time, time.Time, error) {
+	to := time.Now()
+
+	var err error
+
+	if value := values.Get("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			return to, to, fmt.Errorf("invalid to, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	from := to.Add(-usageDefaultPeriod)
+
+	if value := values.Get("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			return from, to, fmt.Errorf("invalid from, expected RFC 3339 date: %s", value)
+		}
+	}
+
+	if !from.Before(to) || to.Sub(from) > usageMaxPeriod {
+		return from, to, fmt.Errorf("invalid period, from must be before to and at most %d days earlier", usageMaxPeriod/(24*time.Hour))
+	}
+
+	return from, to, nil
+}
+
+// History cursors are opaque to clients so that pagination can change without breaking them.
+func encodeHistoryCursor(lastID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("id:" + strconv.Itoa(lastID)))
+}
+
+func decodeHistoryCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("api: decode cursor: %w", err)
+	}
+
+	lastID, err := strconv.Atoi(strings.TrimPrefix(string(data), "id:"))
+	if err != nil || !strings.HasPrefix(string(data), "id:") {
+		return 0, fmt.Errorf("api: invalid cursor %q", data)
+	}
+
+	return lastID, nil
+}
+
+// isDegraded returns true if the snapshot may be outdated since fetching from source fails.
+func (a *APIServer) isDegraded(snapshot *UpdaterSnapshot) bool {
+	return snapshot.Stale(a.staleThreshold)
+}
+
+func (a *APIServer) newPollResponse(update Update) PollResponse {
+	response := NewPollResponse(update)
+	response.Degraded = a.isDegraded(a.updaterState.Snapshot())
+
+	return response
+}
+
+// snapshotAt returns current snapshot or, if "at" query parameter is set, the reconstructed past one.
+// HTTP status code is returned along with the error.
+func (a *APIServer) snapshotAt(r *http.Request) (*UpdaterSnapshot, int, error) {
+	value := r.URL.Query().Get("at")
+	if value == "" {
+		return a.updaterState.Snapshot(), 200, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, 400, fmt.Errorf("invalid at, expected RFC 3339 date: %s", value)
+	}
+
+	snapshot, err := a.history.StateAt(r.Context(), at)
+	if err != nil {
+		log.Errorf("api: reconstruct state at %s: %v", at, err)
+
+		return nil, 500, errors.New("internal server error while fetching data from DB")
+	}
+
+	return snapshot, 200, nil
+}
+
+func (a *APIServer) writeCurrentMap(rw http.ResponseWriter) {
+	contentType, data := a.mapGenerator.MapData.Get()
+	rw.Header().Add("Content-Type", contentType)
+	rw.WriteHeader(200)
+	_, _ = rw.Write(data)
+}
+
+const regionForbiddenMessage = "Your API key has no access to this region"
+
+// requestAPIKey returns the key that the request was authenticated with.
+func requestAPIKey(r *http.Request) *APIKey {
+	apiKey, _ := r.Context().Value(apiKeyContextKey).(*APIKey)
+
+	return apiKey
+}
+
+// restrictStateIDs returns IDs of states that a history query has to be limited to,
+// or false if the requested state is not allowed.
+func restrictStateIDs(apiKey *APIKey, stateID int) ([]int, bool) {
+	if stateID != 0 {
+		return nil, apiKey.Allows(stateID)
+	}
+
+	if !apiKey.IsRestricted() {
+		return nil, true
+	}
+
+	return apiKey.Regions, true
+}
+
+// keyRateLimiter returns the default rate limiter, or a dedicated one if the key has a custom quota.
+func (a *APIServer) keyRateLimiter(apiKey *APIKey) throttled.RateLimiter {
+	if apiKey.RatePerSec == 0 {
+		return a.apiKeyRateLimiter
+	}
+
+	burst := apiKey.RateBurst
+	if burst == 0 {
+		burst = apiKey.RatePerSec
+	}
+
+	a.keyRateLimitersMutex.Lock()
+	defer a.keyRateLimitersMutex.Unlock()
+
+	rateLimiter, ok := a.keyRateLimiters[apiKey.ID]
+	if !ok || rateLimiter.perSec != apiKey.RatePerSec || rateLimiter.burst != burst {
+		rateLimiter = keyRateLimiter{apiKey.RatePerSec, burst, CreateRateLimiter(apiKey.RatePerSec, burst)}
+		a.keyRateLimiters[apiKey.ID] = rateLimiter
+	}
+
+	return rateLimiter
+}
+
+// canResume returns true if all events after lastEventID are still remembered by the updates topic.
+func (a *APIServer) canResume(lastEventID int64) bool {
+	oldestEventID := a.updaterState.Snapshot().LastEventID + 1
+
+	if history := a.updates.History(); len(history) > 0 {
+		oldestEventID = history[0].EventID
+	}
+
+	return lastEventID+1 >= oldestEventID
+}
+
 func (a *APIServer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
 	defer log.Debug("api: exit")
 
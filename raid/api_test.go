@@ -0,0 +1,182 @@
+package raid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testAPIKey = "testApiKey"
+
+type testAPI struct {
+	*httptest.Server
+	registry     *Registry
+	updaterState *UpdaterState
+	updates      *Topic[Update]
+	delorean     *Delorean
+	keys         *KeyStore
+	usage        *Usage
+	// Requests are sent from different addresses, so that they are not limited by address.
+	requests int
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updaterState := NewUpdaterState()
+	_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+		*data = *registry.NewSnapshot()
+		data.LastUpdate = time.Now()
+
+		return nil
+	})
+
+	updates := NewTopicWithHistory[Update](updatesHistorySize)
+	delorean := NewDelorean("history", registry, updates)
+	keys := NewKeyStore("keys", []string{testAPIKey})
+	usage := NewUsage("usage", keys)
+	webhooks := NewWebhooks("webhooks", keys, updaterState, updates, time.Minute)
+	apiServer := NewAPIServer(
+		0, keys, nil, updaterState, updates, NewMapGenerator(registry, updaterState, updates), delorean, NewHealth(),
+		usage, webhooks, time.Minute,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(apiServer.CreateRouter(ctx))
+
+	t.Cleanup(func() {
+		cancel()
+		server.Close()
+	})
+
+	return &testAPI{server, registry, updaterState, updates, delorean, keys, usage, 0}
+}
+
+// get sends a request with the test API key and decodes JSON response into result.
+func (a *testAPI) get(t *testing.T, path string, result interface{}) int {
+	t.Helper()
+
+	req, err := http.NewRequest("GET", a.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.requests++
+	req.Header.Set("X-API-Key", testAPIKey)
+	req.Header.Set("X-Forwarded-For", fmt.Sprintf("192.0.2.%d", a.requests))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		t.Fatalf("%s: decode response: %v", path, err)
+	}
+
+	return resp.StatusCode
+}
+
+type historyTestResponse struct {
+	Records    []Record `json:"records"`
+	NextCursor *string  `json:"next_cursor"`
+	Error      string   `json:"error"`
+}
+
+func TestAPIHistory(t *testing.T) {
+	api := newTestAPI(t)
+	start := time.Date(2022, 3, 15, 18, 0, 0, 0, time.UTC)
+
+	for i, update := range []Update{
+		testUpdate(1001, 12, true, start),
+		testUpdate(1002, 14, true, start.Add(time.Minute)),
+		testUpdate(1003, 12, false, start.Add(2*time.Minute)),
+		testUpdate(1004, 9, true, start.Add(3*time.Minute)),
+		testUpdate(1005, 12, true, start.Add(4*time.Minute)),
+	} {
+		update.EventID = int64(i + 1)
+		if i == 1 {
+			update.AlertType = AlertArtillery
+			update.State.SetAlert(AlertArtillery, true)
+		}
+
+		if err := api.delorean.addRecord(update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		ids   []int
+	}{
+		{"", []int{1, 2, 3, 4, 5}},
+		{"?state_id=12", []int{1, 3, 5}},
+		{"?state_id=12&alert=true", []int{1, 5}},
+		{"?alert_type=artillery", []int{2}},
+		{"?from=2022-03-15T18:01:00Z&to=2022-03-15T20:03:00%2B02:00", []int{2, 3}},
+	}
+
+	for _, test := range tests {
+		response := historyTestResponse{}
+		if status := api.get(t, "/api/history"+test.query, &response); status != 200 {
+			t.Fatalf("%s: got status %d: %s", test.query, status, response.Error)
+		}
+
+		if ids := recordIDs(response.Records); fmt.Sprint(ids) != fmt.Sprint(test.ids) {
+			t.Errorf("%s: got records %v, want %v", test.query, ids, test.ids)
+		}
+
+		if response.NextCursor != nil {
+			t.Errorf("%s: got next cursor %q for the last page", test.query, *response.NextCursor)
+		}
+	}
+
+	// Pages are followed with next_cursor until it's null.
+	pages := [][]int{}
+
+	for path := "/api/history?state_id=12&limit=2"; ; {
+		response := historyTestResponse{}
+		if status := api.get(t, path, &response); status != 200 {
+			t.Fatalf("%s: got status %d: %s", path, status, response.Error)
+		}
+
+		pages = append(pages, recordIDs(response.Records))
+
+		if response.NextCursor == nil {
+			break
+		}
+
+		path = "/api/history?state_id=12&limit=2&cursor=" + *response.NextCursor
+	}
+
+	if fmt.Sprint(pages) != "[[1 3] [5]]" {
+		t.Errorf("got pages %v, want [[1 3] [5]]", pages)
+	}
+
+	for _, query := range []string{"?cursor=foo", "?limit=0", "?alert_type=foo", "?from=yesterday"} {
+		response := historyTestResponse{}
+		if status := api.get(t, "/api/history"+query, &response); status != 400 || response.Error == "" {
+			t.Errorf("%s: got status %d, want 400 with error", query, status)
+		}
+	}
+}
+
+func recordIDs(records []Record) []int {
+	ids := []int{}
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,7 @@
+-- Databases created before migrations were introduced already have this table.
+CREATE TABLE IF NOT EXISTS events (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	date timestamp NOT NULL,
+	state_id integer NOT NULL,
+	alert bool NOT NULL
+);
@@ -0,0 +1,11 @@
+-- Builds before migrations were introduced added these columns and indexes at startup, see migrate.
+ALTER TABLE events ADD COLUMN alert_type text NOT NULL DEFAULT 'air_raid';
+ALTER TABLE events ADD COLUMN district_id integer NOT NULL DEFAULT 0;
+ALTER TABLE events ADD COLUMN event_id integer NOT NULL DEFAULT 0;
+-- Records created before message IDs were introduced have message_id = 0.
+ALTER TABLE events ADD COLUMN message_id integer NOT NULL DEFAULT 0;
+
+-- Dates are stored as text with varying UTC offsets, so they are compared as Julian days.
+CREATE INDEX IF NOT EXISTS events_state_id ON events (state_id, id);
+CREATE INDEX IF NOT EXISTS events_date ON events (julianday(date));
+CREATE UNIQUE INDEX IF NOT EXISTS events_message_id ON events (message_id) WHERE message_id != 0;
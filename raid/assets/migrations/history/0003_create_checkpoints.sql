@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS checkpoints (
+	record_id integer NOT NULL PRIMARY KEY,
+	date timestamp NOT NULL,
+	snapshot text NOT NULL
+);
+CREATE INDEX IF NOT EXISTS checkpoints_date ON checkpoints (julianday(date));
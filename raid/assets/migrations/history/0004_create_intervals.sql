@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS intervals (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	state_id integer NOT NULL,
+	district_id integer NOT NULL,
+	alert_type text NOT NULL,
+	started_at timestamp NOT NULL,
+	ended_at timestamp,
+	duration real,
+	start_record_id integer NOT NULL,
+	end_record_id integer
+);
+CREATE INDEX IF NOT EXISTS intervals_started_at ON intervals (julianday(started_at));
+CREATE UNIQUE INDEX IF NOT EXISTS intervals_open ON intervals (state_id, district_id, alert_type) WHERE ended_at IS NULL;
@@ -0,0 +1,2 @@
+-- Checkpoints were dated by their last record instead of the latest record, they are rebuilt on startup.
+DELETE FROM checkpoints;
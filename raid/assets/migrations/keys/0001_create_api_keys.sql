@@ -0,0 +1,12 @@
+CREATE TABLE api_keys (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	key_hash text NOT NULL UNIQUE,
+	owner text NOT NULL,
+	created_at timestamp NOT NULL,
+	updated_at timestamp NOT NULL,
+	expires_at timestamp,
+	enabled boolean NOT NULL DEFAULT 1,
+	regions text NOT NULL DEFAULT '',
+	rate_per_sec integer NOT NULL DEFAULT 0,
+	rate_burst integer NOT NULL DEFAULT 0
+);
@@ -0,0 +1,11 @@
+CREATE TABLE usage (
+	key_hash text NOT NULL,
+	hour timestamp NOT NULL,
+	endpoint text NOT NULL,
+	requests integer NOT NULL DEFAULT 0,
+	rejected integer NOT NULL DEFAULT 0,
+	bytes_sent integer NOT NULL DEFAULT 0,
+	connection_seconds real NOT NULL DEFAULT 0,
+	PRIMARY KEY (key_hash, hour, endpoint)
+);
+CREATE INDEX usage_hour ON usage (julianday(hour));
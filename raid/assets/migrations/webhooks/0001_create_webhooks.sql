@@ -0,0 +1,31 @@
+CREATE TABLE webhooks (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	key_hash text NOT NULL,
+	url text NOT NULL,
+	regions text NOT NULL DEFAULT '',
+	secret text NOT NULL,
+	created_at timestamp NOT NULL
+);
+CREATE INDEX webhooks_key_hash ON webhooks (key_hash);
+CREATE TABLE deliveries (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	webhook_id integer NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+	event_id integer NOT NULL,
+	payload text NOT NULL,
+	status text NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	next_attempt_at timestamp NOT NULL,
+	created_at timestamp NOT NULL
+);
+CREATE INDEX deliveries_pending ON deliveries (julianday(next_attempt_at)) WHERE status = 'pending';
+CREATE INDEX deliveries_webhook_id ON deliveries (webhook_id);
+CREATE TABLE delivery_attempts (
+	id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+	delivery_id integer NOT NULL REFERENCES deliveries (id) ON DELETE CASCADE,
+	webhook_id integer NOT NULL,
+	attempted_at timestamp NOT NULL,
+	status_code integer NOT NULL,
+	error text NOT NULL,
+	duration real NOT NULL
+);
+CREATE INDEX delivery_attempts_webhook_id ON delivery_attempts (webhook_id);
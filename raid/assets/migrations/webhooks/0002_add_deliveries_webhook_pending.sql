@@ -0,0 +1,2 @@
+-- Deliveries of each webhook are sent in order, starting from the oldest pending one.
+CREATE INDEX deliveries_webhook_pending ON deliveries (webhook_id, id) WHERE status = 'pending';
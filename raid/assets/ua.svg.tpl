@@ -12,103 +12,103 @@
     </path>
     <path
         d="M539.1 499.9l-2 3.2-2.5-1.1-1.3-0.3-1.2-0.1-0.8 0.5-0.9 0.9-0.3 0.4-0.2 0.6-0.1 0.8 0.1 2.5-1.3-3.4-0.4-0.5-0.8-0.3-6.5-6.6-0.9-1.9 1.8 1.5 1.5 1.5 0.8 0.5 9.7-0.8 1.9 0.6 3.1 1.9 0.3 0.1z m65.5-117.8l0.6 0.5-0.1 0.5-0.3 0.8-0.4 1.5 0 0.5 0.1 0.3 0.3 0.2 0.6 0 0.3-0.1 0.4 0.1 0.4 0.2 0.5 0.8 0.2 0.5 0 0.3-0.4 0.5-0.1 0.3-0.1 0.4-0.1 1.9-0.1 0.4-0.2 0.5-0.2 1.1 0 4.5 0 0.6 0.2 0.4 0.4 0.3 0.3 0.3 0.3 0.5 0.3 1.1-0.1 0.4-0.2 0.3-2.4 0.2-2.1 0.6-0.3 0.1-0.4 0.4-0.3 0.2 0.1 0.5 0.3 0.8 0.9 1.6 0.4 1 0.3 0.9 0.1 0.8 0 0.5-0.2 0.4-0.3 0.4-0.6 0.7-0.4 0.6-0.1 0.6 0 0.4 0.1 0.2 0.2 0.2 0.4 0.2 4.7 0.7-0.1 0.7-0.1 1 0.1 0.6 0.1 0.5 0.3 0.5 0.8 0.7 0.3 0.3 0.2 0.5 0 0.3-0.4 0.9 0 0.3 0 0.5 0.4 0.8 0.2 0.6 0.2 0.4 0 0.7-0.2 0.6-0.1 0.7 0 0.4 0.1 0.6 0.4 0.9 0 0.6-0.1 0.3-0.2 0.2-0.5 0.2-1.1 0.1-0.6-0.2-0.1-0.2-0.1-0.2-0.3-1.1-0.6-1.2-0.1-0.2-0.2-0.1-0.2 0.1-0.1 0.5-0.2 0.7-0.2 0.4-0.8 1.2-0.3 0.7 0 0.2 0.2 0.5 0.3 0.4 0.4 0.4 0.6 0.2 1.9 0 0.2 0.1 0.3 0.1 0.1 0.2 1.2 2.7 0.2 0.8 0 0.5 0 0.5-0.2 0.7-0.5 1.5-0.3 1-0.1 0.7 0 0.6 0.2 0.5 0.3 0.8 1.2 2.1 0.1 0.3 0 0.2-0.3 0.7-0.2 0.6-0.1 0.3-0.3 0.2-0.4 0-0.3-0.1-0.7-0.4-0.3-0.1-0.3 0.1-0.4 0.2-1.6 1.9-0.3 0.2-0.4 0.2-0.8 0.1-1.3 0-0.1-0.1-0.6-0.7-0.2-0.1-0.3 0.1-0.3 0.4-0.5 1.4-1.4 3.1-1 1.3-0.2 0.6 0.1 0.5 0.1 0.6 0.1 0.8 0.1 0.2 0.2 0.2 0.3 0 0.8-0.1 0.6 0.1 3.2 1.9 2.5 2.4 0.1 0.3 0 0.2-0.2 0.6-0.4 0.7-1 1.1-0.2 0.3-0.1 0.4 0 0.5-0.1 0.1-1.5-1-0.3-0.3-0.3-0.4-0.3-0.4-0.4-0.3-0.5 0.2-0.6 0.5-0.2 0.4-0.1 0.4 0.1 0.9 0 0.6 0.2 0.5 0.4 0.4 0.2 0.1 2 0 0.4 0.2 0.3 0.4 0.1 0.2-0.3 0.4-0.6 0.3-2.2 0.6-0.8 0.4-1.2 1.4-0.5 0.2-5.4 0.9-0.3 0.1-0.3 0.4 0 0.3 0.1 0.3 0.2 0.2 0.4 0.2 0.4 0.2 1.3 0.1 3-0.3 0.4 0.1 0.5 0.3 0.3 0.1 0.5-0.1 0.2 0.1 0.2 0.2-0.6 0.4-0.9 0.5-4.3 1.5-0.5 0.3-0.3 0.1-2.7-0.1-3 0.4-0.6 0-0.4-0.2-0.2-0.2-0.3-0.4-0.4-0.7-0.3-0.4-0.3-0.1-1.2-0.4-0.3-0.2-0.4-0.3-0.4-0.1-2.2 0-0.6-0.1-0.4-0.2-0.2-0.1-0.5-0.1-0.2-0.1-0.4-0.4-0.2-0.1-0.3 0-0.2 0.1-0.1 0.4 0 1.2 0 0.3-0.2 0.7-0.3 0.4-0.3 0.2-0.4 0.1-2 0.2-0.5-0.1-0.4-0.2-0.3-0.8-0.2-0.2-0.2-0.1-0.3 0-1.2 0.1-1.9 0.5-0.4 0.2-0.4 0.5-0.2 0.4-0.7 2-0.1 0.2-0.3 0.2-0.4 0.1-1.5-0.1-3.2-1.2-0.6 0-0.7 0.1-0.4 0.2-0.3 0.2-0.5 0.4-0.2 0.4-0.1 0.4-0.6 1.5-0.5 1-0.2 0.3-0.3 0.4-0.4 0.3-1 0.4-1.7 0.5-0.6 0-0.7-0.2-0.9-0.6-0.6-0.1-0.4 0.1-0.5 0.1-0.9 0.7-0.5 0.5-0.8 0.3-2.8-0.1-0.1 0.4-0.2-0.5-0.6-1.1-0.5-1.4-0.3-3.3 1.1-2.6 1.5-2.3 1.1-2.8 0.3-1.6-0.1-1.3-0.6-0.8-2.7-0.5-0.6-0.7 0.2-1 1.6-2.5 0.5-0.9-0.3-0.6-1.5-0.2-2.5 0.5-1-0.1-0.9-1 1.6-3.4 0.2-2-0.8-2.1-0.8-0.7-0.7-0.2-0.6-0.3-0.4-1.3-0.2-1.5 0-0.9-0.2-0.6-0.8-0.9-3.2-1.5-0.6-1 0.3-1.7-0.1-0.6-0.5-0.2-0.2 0.3-0.8 1.4-0.2 0.5 0.3 0.9 0.9 1 1.1 0.7 2.4 0.6 0.6 1 0 4.7 0.2 0.5 1.4 0.3 0.4 0.4 0.2 0.3 0.3 0.2 0.7 0.6-0.4 1.4-0.8 1.4-0.4 0.5-0.4 3.7 1.7 0.8 2.5-0.4 1.8 0.3-1.6 1.9-0.7 1.3-0.3 1.3 0.5 1.4 1 0.3 1.2-0.4 1.1-0.7 0.3 1.7-0.6 2.4-1 2.2-1.2 0.9-1.3 0.4-1.2 1.2-0.7 1.8 0 2.1 2.1 2.8 0.2 0.6-0.4 3.6 0.2 1.3 0.2 0.7 0.1 0.5-0.5 1-0.4 0.5-1.2 0.9-0.7 0.3-2.4 0.5-2.4-0.4-5.4-2.1-3.3 0.3-0.9 0.6-0.5 1.1-0.5 0.9-1.3 0.2-0.4-0.2-0.8-0.8-0.5-0.1-1.2 0.1-0.6-0.1-0.4-0.3-0.3-1.1 0.6-1.4 3-5.2 0.8-1.7 0-0.8 0.7-0.6 2.5-3.8-0.6-1-0.5 0.4-0.4 1.2-0.4 1-0.4 0.3-1 0.5-0.5 0.3-0.3 0.4-1.1 1.7 0 0.3-0.2 0.2-0.7 0.2-0.7-0.4-0.7-0.7-0.8-2-0.6-1-0.9-0.4 0.6 2.1 0.1 0.5 0.1 1.2 0.4 0.4 0.5 0.3 1.7 1.4 0.2 0.6-0.4 1.1-1 1.6-0.1 0.3-0.9 0.7-1.9 3-0.7 0.7-1 0.2-2.5 1.4-0.8 0.6-0.8-0.4-1.5-0.2-0.7-0.5-0.4 0.6-1.1-0.7-3.8-1-0.8-4.5 0-1.5 0.1-0.4 0.2-0.5-0.1-0.5 0-0.5-0.3-0.8-0.4-6.4-0.1-0.8-1-0.8-0.8-0.4-0.4-0.5-0.6-1.4-0.4-0.7-0.3-0.4-2.4-1.6-0.3-0.6-0.5-1-0.5-1.7 0-0.8 0.1-0.4 0.2 0 2.8-0.2 0.4-0.3 0.6-1 0.2-0.2 0.2-0.1 0.6 0 0.2 0.1 0.4 0.3 0.2 0.1 0.8-0.2 3.4-0.3 0.3 0 0.2-0.2 0.3-0.7 0.2-0.3 0.2-0.1 1.1-0.6 0.3-0.3 0.3-1 0.3-1.2 0.3-0.5 0.3-0.4 0.3-0.3 0.3-0.5 0.1-0.5 0-0.6 0-0.8 0.6-1.4-0.1-0.6-0.1-0.3-0.2-0.2-0.2-0.1-1-0.1-0.3-0.1-0.2-0.2-0.2-0.4-0.1-0.6-0.4-0.7-0.1-0.2-0.6-0.6-0.4-0.6-0.3-0.8-0.2-0.4-0.2-0.2-0.6 0-0.5 0.2-0.7 0.3-0.5 0.1-0.5-0.2-0.6-0.5-0.5-0.3-1.3-0.3-0.3-0.1-0.2-0.3-0.2-0.3-0.1-0.6 0-0.7 0.1-0.7 0.2-0.2 0.5-0.2 0.5-0.2 0.5-0.2 0.2-0.2 0.8-0.8 0.1-0.3 0.2-0.6-0.1-0.7-0.1-0.5-0.4-0.9-0.4-0.4-0.2-0.1-4.4 1-1.7 0.7-0.3 0-0.3-0.1-0.6-1.1-0.2-0.2-0.2 0-2.2-0.1-3.1-0.6-0.7-1.1-0.9-2.1-2.6-7.9-0.3-1.3 0.7-1.8 0.3-1.3 0.2-0.8 0.1-0.8-0.1-0.6 0.1-0.5 0.4-0.3 0.2-0.2 0.2-0.6 0-0.8-0.2-1.7-0.3-0.5-0.8-0.9-0.6-1.1-0.3-0.5-0.4-0.3-1.9-0.1-0.3-0.1 0-0.3 0.1-0.2 0.2-0.5 0-0.6-0.1-1.5-0.2-0.5-0.3-0.3-3.1 0.7-0.2 0.2-0.2 0.2 0 0.4-0.3 1.3-0.3 0.6-0.3 0.4-0.3 0-0.3-0.2-0.2-0.3-0.3-0.6-0.3-1.5-0.2-0.3-0.3-0.2-2-0.1-0.3 0-0.4-0.2-0.7-0.6-0.3-0.1-0.3 0-0.3 0.1-0.5 0.6-0.9 0.6-0.5 0.3-0.2 0-0.3 0-0.3-0.2-0.4-0.5-0.3-0.1-0.3 0-2.5 0.5-0.3-0.1-0.7-0.8-0.2-0.2-1.3-0.7-0.6-0.4-0.3-0.7-0.2-0.8-0.4-1-0.1-0.7 0.1-0.6 0.4-0.8 0.1-0.3 0.1-0.4 0-0.6-0.4-0.6-0.7-0.5-0.7-0.8-0.2-0.3 0-0.4 0.1-0.3 0.2-0.2 0.5-0.6 0.2-0.2 0.2-0.4 0-0.4-0.3-1.2-0.3-0.4-0.3-0.2-1.4-0.6-0.6-0.4-0.9-0.8-0.4-0.7-0.1-0.7-1.4-8.9-0.1-0.3-0.2-0.3-1-1.3-0.6-0.8-0.4-0.5-0.2-0.1-1.4-0.4-0.4-0.2-0.2-0.5 0-0.8 0.1-0.6 0.4-0.7 0.1-0.6-0.1-0.3 0.1-1.1-0.1-0.4-0.1-0.3-0.7-0.5-0.1-0.2 0.1-0.2 0.6-0.6 1.9-0.8 0.9 0.1 0.2-0.1 0.3-0.4 0.2-0.2 0.3 0 0.3 0 0.6 0.2 0.3-0.1 0.2-0.2 0.1-0.4 0-0.4-0.3-0.3-0.7-0.4-0.2-0.2-0.1-0.4 0-1.5 1.6 0.1 0.4-0.1 0.3-0.5 0.4-1.5 0.3-0.3 8.5 0 0.5 0.1 0.5 0 0.4 0.1 2.5 0.9 2.1 0.5 0.6 0 0.3-0.2 0.3-0.4 0.2-0.7 0.1-0.2 0.5-0.3 0.7-0.4 2.6-0.9 0.6-0.1 1.6 0.3 1.7 0 0.7 0.1 0.5 0.2 0.1 0.6 0.2 0.3 0.2 0.3 0.6 0.2 0.3 0 0.2-0.2 0.4-1.1 0.2-0.3 0.2-0.2 0.5-0.4 0.4-0.1 0.3 0.1 0.3 0.1 0.4 0.4 0.1 0.3 0 0.6 0.2 0.3 0.2 0.3 0.8 0.3 0.4 0.1 0.4-0.1 0.4-0.3 0.2-0.2 0.3-0.4 0.4-0.3 1.8-0.6 0.4-0.2 0.2-0.2 0.2-0.6 0.1-0.7 0.5-0.5 0.7-0.4 1.9-0.7 1.2-0.1 4 0.3 0.9 0.3 0.9 0.1 0.5 0.2 0.4 0.1 0.1 0.6 0.2 2.2 0 0.3 0.2 0.2 0.4 0.3 1.3 0.3 0.2 0.2 0.1 0.4 0 1.3 0 0.3 0.1 0.3 0.2 0.4 0.3 0.2 0.6 0.3 5.1 0.7 0.5 0 0.9-0.7 0.9-0.4 0.2 0.1 0.2 0.2 0.2 0.6 0.3 0.2 0.4 0.3 0.4 0.1 0.2-0.1 0.2-0.2 0.5-0.2 0.7-0.2 3.1-0.3 0.4 0.3 0.2 0.4 0.1 0.6-0.2 1.8-0.2 0.6-0.3 0.7 0 0.5 0.3 0.3 0.3 0.1 0.4 0 0.2-0.1 0.2-0.2 0.1-0.6 0.1-1 0.1-0.4 0.2-0.3 0.4-0.3 0.3 0 0.2 0.2 0.2 0.3 0.3 0.1 0.3 0 0.8-0.1 0.5-0.3 0-0.3 0-0.9 0-0.3 0.1-0.3 0.2-0.2 0.3-0.2 0.9-0.4 0.4 0.1 0.2 0.1 0.8 0.9 0.3 0.2 0.5 0.2 0.7-0.1 0.4 0.1 0.4 0.1 0.5 0.4 0.4 0.1 0.3 0.1 2.2-0.5 0.5 0.1 0.3 0.2 0.1 0.2 0.4 1.4 0.3 0.8 0 0.6-0.1 0.7-0.1 0.3-0.4 0.4-0.6 0.5-2.2 1-0.2 0.1 0.1 0.5 0.8 1.4 1.7 3 0.7 0.9 0.7 0.6 0.3 0.1 1.4-0.3 0.3 0.1 0.3 0.1 0.3 0.4 0.2 0.5 0.1 0.9 0.2 0.5 0.2 0.2 1.2 1.5 0.2 0.5 0.1 0.6 0 0.3-0.3 0.5-0.9 1.5-0.3 0.5 0 0.3 0.1 0.6 0.6 0.9 0 0.6-0.1 0.7 0 0.4 0.1 0.4 0.2 0.4 0.3 0.2 0.4 0 4.8 0 0.3-0.1 0.2-0.2 0.2-0.9 0.4-0.2 0.6-0.1 2 0.3 0.4 0.2 0.6 0.6 1.3 0.7 0.2 0.1 0.4 0.5 0.5 0.9 0.2 0.5 0.2 0.4 0.5 0.3 1.1 0.6 0.6 0.2 0.4 0.1 0.6-0.1 0.5-0.2 0.3-0.1 0.3-0.4 0.7-0.9 0.7-1.5 1.2-2 0.3-0.4 0.3-0.2 0.4-0.2 2.1-0.3 0.9 0.2 0.6 0.2 1.5 0.9 1 1 0.2 0.1 0.3-0.1 0.6-0.3 0.6-0.1 0.7 0.1 0.2 0.2 3.7 1.3 0.5 0.1 9.6-1.6 0.3 0 0.1-0.3 0.1-0.2 0-1.3 0-0.3 0.1-0.7 0.1-0.3 0.2-0.1 1.1-0.2 0.2-0.2 0.1-0.2 0-0.2-0.5-0.6-0.1-0.3 0-0.3 0.1-0.7-0.1-0.6-0.4-0.7-0.2-0.5 0.1-0.3 0.2-0.5 0.2-0.1 0.6-0.2 1.5-0.2 0.2 0 0.2-0.2 0.1-0.6 0-0.7-0.1-0.2-0.2-0.1-0.9-0.2-0.3-0.1-0.3-0.4-0.1-0.6 0.1-0.7 0.2-0.6 0.3-0.5 0.2-0.2 0.4-0.3 0.7-0.4 2.3-0.4 1.1-0.4 0.3 0 0.2 0.2 0.6 0.9 0.2 0.2 0.3 0.3 0.6 0.3 0.4 0 0.3-0.1 0.1-0.2 0.4-0.9 0.1-0.3 0.2-0.2 1.7-0.3 0.4-0.3 0.2-0.2 0-0.4-0.2-0.8-0.1-0.6 0.1-0.6 0.1-0.3 0.2-0.3 0.4-0.2 0.7-0.2 0.6 0 1.4 0.3 0.3-0.1 1.2-0.8 0.3 0 0.2 0.1 0.2 0.6 0.1 0.5 0.2 0.6 0.2 0.4 1 1.2 0.2 0.4 0.1 0.5 0 1 0 0.3 0.2 0.2 0.9 0.7z"
-        id="13" name="Mykolayiv" fill="{{ if (index .alerts 13) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="13" name="Mykolayiv" fill="{{ if (index .alerts "13") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M624.8 3.2l-0.2 0.5-0.4 1.5-0.2 1.4-0.1 0.6-0.7 1.1-0.3 0.6-0.1 0.7-0.1 1.7-0.2 0.6-0.5 0.9-1.5 1.5-0.3 1.1 0.3 4.3 0.2 0.8 0.2 0.2 2.4 0.8 0.3 0.2 1.3 1.3 1 1.4 0.6 0.7 0.2 0.2 0.3 0.8 0.2 0.2 0.3 0.1 1.7 0.2 0.3 0.1 0.1 0.2 0 0.4-0.1 0.5-0.1 0.5-0.4 1-0.3 0.4-0.3 0.2-1.2 0.3-1.3 0.2-0.7-0.1-0.3-0.1-0.2-0.1-0.3-0.6-0.3-0.4-0.2 0-0.3 0.1-0.2 0.3-0.1 0.6 0 2.9 0 0.4-0.1 0.5-0.3 0.5-0.7 0.8 0 0.2 0.3 0.2 0.9 1.5 0.1 1-0.6 1-3.1 1.1-1.3 0.2-0.8 0.3-2 1.7-0.6 0.4-2.5-0.2-1.1 0.3 0.4 1.1 0 0.7-1.4 0.4-0.6 1.2-0.5 1.4-1.1 0.7-0.7 0.7-1 1.7-0.5 2.1 0.3 1.9 0.3 0.7 0.6 2 0.4 0.7 0.4 0.6 3 3 0 1-0.9 1.2-0.1 1 0.2 0.8 0.2 0.3 0.3 0.2 0.3 0.1 0.2 0.3 0.1 0.4 0.2 3.6 0.2 0.4 0.3 0.2 0.4 0.4 0.2 0.3 0 0.2-0.1 0.3-0.3 0.2-0.9 0.3-0.3 0.3-0.1 0.5 0.1 1.1 0.1 0.6 0.3 0.4 1.1 1 0.2 0.2 0.2 0.5-0.2 0.4-0.8 1.1-0.2 0.1-0.5-0.2-1.7-0.9-0.2 0 0 0.2 0.5 1.8 0 0.4 0 0.4-0.3 0.5-0.3 0.2-0.5 0.3-0.1 0.4-0.1 0.7 0.3 1.4 0.2 0.6 0.3 0.4 0.4 0.4 0 0.4-0.4 0.6-0.1 0.4 0 0.5 0.1 0.7 0 1-0.2 0.5-0.2 0.3-0.2 0.2-0.6 0.3-1.1 0.2-0.7 0-0.7-0.2-0.2-0.2-0.3-0.5-0.4-1.1-0.2-0.3-0.2-0.2-0.6-0.2-0.3 0.1-0.4 0.5-0.4 0.8 0 0.3 0 0.5 0.3 0.5 0.5 1 0.2 0.6 0.2 0.4 0.2 0.2 0.3 0 0.7 0 0.2 0.1 0.2 0.2 0.2 0.2 0 0.4 0 0.4-0.2 0.8-0.1 0.5 0 0.5 0.2 0.5 0.2 0.3 0.7 0.6 0.2 0.3 0 0.2-0.3 0.7-0.2 0.3 0 0.5 0.3 1.1 0.1 0.3-0.1 0.3-0.2 0.4-0.3 0.2-1.5 0.8-0.2 0.4 0 0.5 0.1 1-0.1 0.6-0.1 0.6-0.5 0.5-2.9 1-0.2 0.3-0.1 0.5 0 1.2 0.3 0.8 0.1 0.5 0.1 0.4-0.2 0.6-0.1 0.3-1.3 2.3-0.1 0.5 0.3 0.3 2.1 0.6 2.1 1 0.7 0.1 1.1-0.3 0.3 0 0.2 0.2 0.1 0.2 0.1 0.3-0.1 0.4-0.3 0.4-0.8 0.5-0.1 0.3 0.1 0.3 0.5 0.5 0.5 0.4 1.2 0.6 2.9 0.6 0.3 0 0.2-0.2 0.2-0.2 1.1-2.5 0.2-0.2 0.3 0 0.7 0.2 0.6 0.3 0.2 0.2 0.1 0.4-0.5 1-0.8 1.6-0.6 0.6-0.9 1-0.1 0.2 0 0.7 0.2 0.4 0.2 0.3 1.1 1 0.2 0.3 0.1 0.5-0.2 0.9 0 2 0 1-0.1 0.5-0.1 0.6-0.5 0.8-0.4 0.2-0.3 0.2-0.2 0.1-0.2 0.3-0.2 0.5-1.3 5-0.1 0.9 0 1.6 0 0.9 0.2 0.7 0.1 0.4 0.6 0.6 0.7 0.6 0.4 0.2 0.3 0.2 0.2 0.4 0 0.6-0.2 0.6-0.4 0.6-0.5 0.4-0.4 0.3-0.3 0.4-0.2 0.6-0.2 0.9 0 1.4-0.1 0.3-0.1 0.4-0.4 0.4-0.6 0.3-0.2 0.3-0.1 0.3 0.1 0.6 0.2 1 0 0.4-0.1 1.1-0.5 2-1.9 5.5-2.1 4.1-0.8 1-1.3 0.6-0.7 0.4-0.3 0.4-0.3 0.4-0.3 1-0.3 0.3-0.3 0.2-0.9 0.3-1.3 0.8-0.3 0.4-0.3 0.4-0.3 0.9-0.3 0.8-0.3 0.3-0.3 0.2-0.5 0.2-2 0.2-0.9 0.2-4.7 2.6-2 0-0.3-0.1-0.2-0.5-0.3-0.2-0.5 0-2.2 0.4-5.6-0.2-1.3-0.4-0.2-0.3-0.3-0.5-0.2-0.5-0.2-0.9-0.2-0.6-0.3-0.4-0.3-0.1-0.4 0-0.8 0-0.4 0.1-1.7 1.2-0.3 0.1-0.2-0.1-0.4-0.5-0.5-0.6-0.2-0.2-0.4-0.1-1.4 0.1-3.5 1.6-0.4 0.4-0.4 0.4-1.1 1.9-0.6 0.7-0.6 0.4-0.5 0.2-0.3 0-0.3-0.1-0.4-0.4-0.3-0.1-3.3 0-3.4-2-0.3-0.4-0.3-0.5 0.3-0.4 0.8-0.8 0.1-0.3 0-0.4-0.1-0.2-0.3-0.2-0.6-0.1-0.7 0.1-0.4 0-0.5-0.1-0.8-0.4-0.4-0.3-0.3-0.3-0.2-0.5-0.1-0.6 0-0.8-0.1-0.6-0.2-0.3-0.2-0.2-1.3-1.1-0.2-0.5 0-0.4 0.1-0.3 0.3-0.5 0.6-0.9 0.2-0.1 0.3-0.1 1.2 0.2 0.3-0.2 0.2-0.2 0.1-0.6 0.1-1.2-0.1-1.2-0.3-0.5-0.3-0.3-2.3 0-0.7-0.1-0.3-0.1-0.5-0.4-0.5-0.6-2-2.7-0.7-0.5-1.6-1.6-0.6-0.4-0.5-0.2-1.2 0.1-1.1 0.4-0.5 0.3-0.1 0.3-0.1 0.9-0.1 0.3-0.3 0.4-0.1 0.3 0 0.3 0 0.6 0 0.2-0.2 0.2-0.5 0.2-0.2 0.2-0.7 1-0.4 0.5-0.2 0.1-0.4 0-2.2-0.2-0.6 0.1-0.5 0.3-0.4 0.4-0.3 0.5-0.2 0.2-0.3 0.1-0.6 0.1-0.3 0.1-0.4 0.3-0.6 0.7-0.2 0.2-0.2 0.2-0.3 0-0.8-0.1-0.3 0.1-0.2 0.1-0.5 0.4-0.5 0.2-0.3 0-3.4-0.8-0.5 0.1-0.4 0.3-0.6 0.7-0.6 0.5-4.3-1.1-1.1-0.1-0.8 0-0.3 0.2-0.3 0.1-0.6 0.2-0.4-0.2-0.3-0.1-0.5-0.4-0.5-0.2-0.6 0-0.4-0.1-0.3-0.1-0.2-0.2-0.3-0.5-0.2-0.5 0.1-1.2-0.1-0.4-0.2-0.4-0.3-0.2-0.4-0.1-1.1 0.1-0.3-0.2-0.3-0.2-1.6-1.6-0.3-0.5-0.1-0.3 0.1-0.3 0.1-0.7 0.2-0.3 0.2-0.1 0.6 0.1 0.2-0.1 0.2-0.2 0.4-0.5 0.2-0.6 0.1-0.4 0-0.7-0.1-0.9-0.3-1.2-0.2-0.5-0.2-0.5-0.4-0.5-0.7-0.8-1.3-1.1-0.2-0.3 0-0.8 0-0.4-0.2-0.2-0.4-0.1-0.8-0.1-0.8-0.1-0.3-0.2-0.2-0.3 0-0.3 0.2-0.7 0.1-0.3-0.1-0.3-0.4-0.5-0.5-0.7-0.2-0.5-0.2-0.3-0.4-0.1-0.3 0-0.5 0.3-0.6 0.5-0.3 0.1-0.6 0.2-0.6 0-3-0.2-0.7 0-0.6 0.2-0.8 0.8-0.4 0.1-0.7-0.1-2.6-0.7-0.3 0.1-0.2 0.1-0.4 0.9-0.3 0.2-0.3 0.2-0.5 0.1-0.3-0.1-0.2-0.2-0.4-0.5-0.3-0.4-0.6-0.6-0.5-0.2-0.4-0.1-1.6 0.5-0.6 0-0.3-0.2-0.2-0.2 0-0.4 0.2-0.5 0.8-1.4 0.5-1.2 0.4-1 0.1-0.7 0.3-1.9 0-0.2-0.2-0.4-0.5-0.5-1.8-1.4-0.5-0.3-3-1.1-0.5-0.3-0.4-0.3-0.1-0.3-0.1-0.6 0-0.7 0.1-0.4 0.3-1.5 0.3-1.5 0-0.7-0.1-0.7-0.2-0.9-0.2-0.5-0.3-0.4-1.4-1.3-0.5-0.3-0.5-0.1-0.3 0-0.5 0-0.7-0.2-0.6-0.5-0.7-0.4-0.3 0-0.2 0.2-0.5 0.4-0.3 0.2-0.6 0-0.3-0.2-0.1-0.2-0.1-0.4-0.2-2.6 0.1-5.4 0.1-0.8 1-4.9 0.1-0.7-0.1-0.7-0.1-0.6-0.7-1.7-0.1-0.5 0.1-0.4 0.2-0.2 0.3-0.1 1.1 0.1 0.3 0 0.2-0.2 0.1-0.2 0.1-0.3-0.1-0.7 0-0.1 0.1-2.1 0.1-0.2 1-1.7 0.1-1.3 1.7-1.2 1.6-1.7-0.3-2.5 0.7-0.3-0.1-0.4-0.4-0.5-0.2-0.5-0.6-2.5-0.2-0.6-0.7-0.5-0.6-0.1-0.4-0.2-0.2-1 0.1-1.2 0.4-0.6 0.6-0.2 0.8 0.2 0-0.7-0.6-0.2-0.6-0.4-0.5-0.7-0.2-1.1 0.3-0.7-0.2-0.7-0.6-0.6-0.7-0.5 0.5-0.4 0.3-0.4 0.4-1-0.9-0.4-1.9-0.6-0.6-0.8 0-0.6 0.7-0.5 0.4-0.1 0-0.6-0.5-0.3-1-0.9 0-0.7 1.6-1.4 0.7-0.3-0.2-0.9-0.2-0.4 0.3-0.2 0.4-0.7 0.4-0.3-0.3-1.3 0.6-1.9-0.3-1.2 0.7-0.2 1.7 0 0.6-0.3 0.4-0.9 0-1.1 0.2-1 0.6-0.7 0-0.6-0.4-0.1-1.1-0.6 0.6-0.7 1.3-0.8 1-1.1-0.1-1.4-0.4-0.8 0-0.4 0.2-0.2 0.4-0.7 1.2-1.6 0.1-0.3 0.4-0.2 0.2-0.6 0.4-0.6 1.1-0.6 0.2-0.8 0-0.8 0.2-0.6 0.5-0.1 1.6 0.1 0.5-0.2 0.5-0.6 0.7-1 0-0.6-0.3-0.4-0.1-0.6 0-0.3 1.6-0.6 0.8-0.5 0.6-0.6 0.7-0.6 0.5-0.8 0-0.8 0.1-0.5 0.4-0.6 0.5-0.3 0.3-0.2 0.3 0.2 0.4 0.5 1.5-0.1 0.4-1.2-0.5-1.2-1.6-0.2 0.2-0.4 0.3-0.9 0.2-0.5-0.3-1.5 0.9-0.9 1.4-0.5 7.5-0.4 2.2 0.2 1.3 0.8 2.6 2.2 1.3 0.5 1.3-0.6 0.9-1.5 0.8-1.8 1.2-1.4 4.3-1.9 5.1 0 9.7 1.9 6.3-0.3 3.4-0.3 1.8 0.3 1.3 1.3 0.3 1.4 0.1 1.7 0.2 1.4 1 0.6 0.9-0.2 3-1.5 2-0.2 3.3 0.9 1.1 0 7.6-3.6 2.4-2 1.7-3.5 0.6-5.4 0.5-1.6 0.6-0.6 0.7-0.4 0.6-0.5 0.3-1.1-0.2-0.7-1.1-1.9-0.2-1 0.7-3.1 2.3-0.5 4.8 1.8 2.6-0.8 10.9 5.8 1.7 0 3.3-0.9 1.6 0 0.9 0.4 1.7 1.4 1 0.1 1-0.5 5.7-5.2 1-0.5 0.5 0.1 0.7 0.4 0.5-0.2 0.5-0.4 0.6-1.3 0.6-0.3 1-0.4 0.7-0.7 1.5-1.8 0.9-0.6 0.7-0.2 5.4 1.1 3.6-0.1 1 0.2 1.3 1z"
-        id="24" name="Chernihiv" fill="{{ if (index .alerts 24) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="24" name="Chernihiv" fill="{{ if (index .alerts "24") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M307.3 79.9l-1.8 0.3-1.3 0.7-0.6 0.2-0.5 0.3-0.2 0.1-0.2 0.6-0.2 0.8-0.2 1.7 0.1 0.7 0.1 0.5 0.9 0.9 0.3 0.5 0.2 0.5 0 0.4-0.1 0.3-0.1 0.3-0.4 0-0.3 0-0.2-0.2-2.2-1.9-0.4-0.5-0.3-0.9-0.3-0.5-0.4-0.4-0.2-0.1-0.3-0.1-0.3 0.1-0.1 0.2-0.2 0.7-0.6 3.9 0 1.1 0.1 0.8 0.9 0.8 0.3 0.5 0 0.4-0.1 0.5-0.4 0.8-0.3 0.7-0.6 0.6-0.5 0.3-3.1 0.7-0.2 0.2-0.1 0.2 0.1 0.4 1.8 3.8 0.1 0.4 0.1 0.5 0 0.4-0.1 0.5-0.2 0.6-0.4 1-0.3 0.4-0.3 0.3-1.3 0.8-0.2 0.2-0.3 0.5-0.7 2.2-0.3 0.5-0.2 0.4-0.4 0.4-0.5 0.6-0.1 0.3-0.1 0.5-0.1 0.6-0.1 2-0.2 0.6-0.3 0.7-0.5 0.7-0.6 0.6-0.2 0.2-0.2 0.4-0.7 1.6-0.3 0.5-0.2 0.3-1.3 0.6-2.2 0.5-0.5 0.3-0.2 0.2-0.2 0.4-0.5 1.8-0.4 0.4-0.1 0.4 0.4 0.8 0.6 1.1 0.1 0.4 0 0.5 0.1 0.8 0.2 0.4 0.2 0.3 0.4 0.4 0.1 0.3 0.1 0.3-0.3 0.5-0.2 0.3-0.6 0.2-0.1 0.3 0.2 0.5 0.2 0.3 0.7 0.9 0.2 0.6 0.1 0.6-0.1 0.9-0.8 4.2-0.1 0.5 0 0.6 0.1 1 0.1 0.5 0.3 0.7 0.5 1 1.1 1.7 0.4 0.8 0.2 0.9-0.1 0.6-0.2 0.9-1.3 3.3-0.4 0.8-1.1 1.4-0.2 0.4-0.6 1.6-0.3 0.9-0.2 0.5 0 0.4 0.3 0.7 0.2 0.4 0.3 0.2 1.1 0.6 0.4 0.4 0.1 0.4-0.4 1.3-2 2.3-2.7-0.6-0.3-0.3-0.3-0.3 0-0.8 0-0.4-0.1-0.5-0.3-0.7-0.3-0.2-0.4-0.2-0.8 0-0.4 0.2-0.4 0.4-0.2 0.6-0.3 1-0.1 0.3-0.5 0.3-1.2 0.3-1.1 0.5-0.4 0.3-0.9 0.8-0.4 0.5-0.3 0.3-0.5 0.4-0.5 0.2-0.4 0-0.7-0.2-1.1-0.6-1.1-0.5-0.4 0-0.3 0.1-1.1 1-3.8 1.6-0.3 0.4-0.2 0.6 0 0.7 0.1 1 0 0.4-0.2 0.3-0.3 0.2-0.3 0.1-0.3-0.1-1.3-0.5-0.6-0.1-0.3 0.1-0.2 0.3-0.1 0.4 0 0.7-0.2 0.5-0.2 0.4-0.6 0.5-0.3 0.4-0.2 0.4 0 0.3-0.3 0.6-0.5 0.6-2.4 1.9-0.1 0.3 0 0.3 0 0.6-0.1 0.5-0.2 0.5-0.3 0.3-2.6 1.9-0.3 0.4-0.5 0.8-0.4 0.9-0.3 0.4-0.5 0.4-2 1.5-0.2 0.2-0.6 1-0.4 0.4-0.9 1-0.5 0.3-0.4 0.1-1.3 0.2-0.4-0.1-0.7-0.2-0.5 0-0.7 0.1-0.5 0.2-0.4 0.2-0.8 0.8-0.4 0.4-2.3 3.6-0.9 1.1-1.2 0.8-2.7 1.3-0.2-2.6-0.1-0.4-0.4-0.4-0.4-0.3-0.5-0.4-0.1-0.4 0-0.5 0.1-0.6 0.3-0.6 0.2-0.6 0.6-0.7 0.2-0.3 0.2-0.6-0.1-0.4-0.1-0.3-0.3-0.4-0.3-0.1-0.2 0.1-0.6 0.7-0.4 0.3-1.6 0.7-0.3 0-0.4 0-0.4-0.1-3.5-1.7-0.7-0.2-0.6 0-0.6 0.2-1.5 0.8-0.5 0-0.8-0.2-1.6-0.6-0.7-0.1-0.5-0.1-1.4 1.1-5.1 5.6-0.4 0.3-0.8 0.4-6.4 0.6-1 0.2-0.5 0.3-0.8 0.8-0.3 0.3-0.6 0.3-0.3 0-0.3-0.1-0.4-0.6-0.3-0.2-0.3 0-0.2 0.1-1.2 1.2-0.3 0.2-0.5 0.3-0.3-0.1-0.9-0.6-1.4-0.4-1.2-0.5-1.4-0.4-0.6-0.1-0.5 0.2-0.2 0.5-0.3 1.4-0.1 0.7 0.1 0.6 0.4 0.4 0.6 0.6 0.2 0.2 0.1 0.2 0 0.3-0.4 0.8-0.1 0.3-0.2 0.4-0.3 0.4-1.6 1-0.2 0.2-0.2 0.7 0.1 1 0 0.3 0 0.8-0.2 0.8-0.2 0-0.4-0.4-0.3-0.2-0.8-0.2-0.3 0.1-0.2 0.3-0.1 0.4-0.1 1.3-4-2.5-5.2-4.6-0.5-0.7-0.4-2.1-0.1-1.1 0.1-1.5 0.4-2.1 0-0.4-0.2-0.5-1.1-1.2-0.2-0.6 0-0.4 0.1-0.2 1.6-1.6 0.4-0.5 0.2-0.5 0.1-0.5-0.1-0.7-0.2-1.1-0.2-0.6-0.2-0.4-0.5-0.3-0.6-0.2-0.4-0.1-0.3 0.1-0.6 0.1-1.5 0.9-0.5 0.2-0.7 0.1-1.1-0.1-0.8-0.4-0.4-0.9 3.3-3.1 1.2-1.4 0.6-1 0.5-0.3 0.5-0.2 0.5-0.1 0.7-0.4 0.4-0.5 0.1-0.4 0-0.4-0.1-0.3-0.1-0.3-0.3-0.1-1-0.2-0.3-0.1-0.2-0.2-1.4-2.5-0.5-0.7-0.8-0.8-1-0.6-0.2-0.3-0.2-0.2-0.1-0.4 0.1-0.3 0.2-0.1 0.3 0 1.1 0.1 0.3 0 0.3-0.1 0.2-0.1 0.4-0.4 0.4-0.6 0.9-1.7 0.1-0.2-0.1-0.2-0.6-0.6-1.6-1.2-0.2-0.3-0.3-0.4 0.1-0.4 0.1-0.3 0.6-0.8 0.3-0.2 0.3-0.1 0.2 0 2.6 0.7 4.2 0.4 0.5-0.1 0.4-0.2 0.6-0.5 0.3-0.4 0.1-0.5 0-0.3 0-0.7 0-0.4-0.3-0.8-0.7-1.3-0.1-0.4 0.1-0.6 0.2-0.2 0.3-0.2 3.9 0.6 0.4-0.1 0.5-0.2 0.1-0.2-0.1-0.3-0.2-0.2-1.2-0.8-0.2-0.2-0.1-0.4 0.1-0.6 0.4-0.6 0.2-0.4 0-0.4-0.3-0.9 0-0.3 0-0.4 0.3-0.1 0.1 0.1 0.9 0.8 0.7 0.4 1.1 0.5 1.4 0.3 0.5 0 0.5-0.2 1.7-1 1.9-0.7 2.1-1.2 1.2-0.9 1.1-0.6 2.9 0 0.7 0.2 0.3 0.1 0.8 0.8 1.1 1.3 0.2 0.3 0 0.3-0.1 0.5 0.2 0.2 0.2 0.2 2.2 0.3 0.6 0.3 0.4 0.4 1.2 1.2 0.4 0.4 0.4 0.1 0.4 0.1 0.5-0.1 0.3-0.1 0.2-0.3 0.5-2.9 0.2-0.3 0.3-0.7 0.4-0.4 0.4-0.7 0.1-0.6 0-0.7 0-0.7-0.3-1.7 0.2-4 0.1-0.3 0.3-0.7 0.3-0.3 0.3-0.2 0.2-0.1 2.5-2 0.4-0.3 0.3-0.1 0.7 0 1 0.3 0.5-0.1 0.3-0.1 0.2-0.2 0.4-0.6 0-0.2-0.2-0.4-0.3-0.2-1.3-0.7-0.2-0.1-0.1-0.4 0.2-0.6 0.7-1.1 1.4-1.3 0.2-0.3 0.2-0.7 0-0.7-0.1-0.3-0.1-0.2-0.3-0.3-0.2-0.3-0.2-0.3-0.9-0.7-0.2-0.2-0.2-0.3 0-0.4 0.2-0.6 0.3-0.3 0.3-0.3 3.9-0.5 0.5-0.2 0.4-0.2 0.8-0.6 0.2-0.4 0.1-0.4-0.1-0.6-0.1-1.1-0.2-0.6-0.5-0.7 0-0.3-0.1-0.7-0.2-0.5-0.9-1.6-0.9-1-0.5-0.4-0.5-0.3-0.6 0.1-0.6 0.1-0.1 0.1-0.6 0.5-0.2 0.1-0.3 0-1.4-0.6-0.3-0.2-0.2-0.2 0-0.5 0.3-2.4 0.1-0.5 0.2-0.2 3.9-0.8 0.5-0.4 0.6-0.6 0.5-0.9 0.3-0.6 0-0.5 0.1-1 0-0.4-0.2-0.6-0.2-0.2-2.8-2.8-4.2-2.1-0.4-0.5-0.3-0.5-1.1-1.3-1.6-1.3-0.4-0.4-0.1-0.3 0-0.3-0.1-0.7-0.2-0.5-0.3-0.3-1-0.5-0.6-0.3-0.3-0.5-0.3-0.5-0.1-0.3 0.1-0.7 0.2-0.9 0.5-2 0-0.8-0.1-0.2-0.2 0.1-0.8 0.8-0.5 0.2-0.4 0.1-0.7 0-0.3-0.1-0.6-0.3-0.3-0.5-0.2-0.5-0.2-0.2-0.3 0-0.2 0.1-0.2 0.2-0.2 0.3-0.4 0.7-0.2 0.3-0.4 0.4-0.2 0.2-0.3 0.1-0.3 0-0.3 0-1.2-0.5-0.7-0.1-0.7 0.1-0.6 0.1-0.6 0.2-0.7 0.5-0.2 0.2-0.3 0.6-0.3 0.9-0.2 0.3-0.2 0.2-0.2 0.1-0.5-0.1-0.2-0.1-0.4-0.5-0.3-0.5-0.1-0.3-0.1-0.7 0.4-3.3 0-0.3-0.1-1.1 0-0.7 0.1-1.2 0.2-1.5 0.1-0.8 0-0.3-0.1-0.7-0.6-2.4-0.2-0.8 0-0.4 0.1-1.4 0.1-0.5 0.1-0.4 0.3-0.5 0.4-0.4 1-0.9 0.3-0.4 0.5-0.9 0.1-0.6 0-0.4-0.3-0.6-0.4-0.3-1-0.3-0.3-0.2-0.1-0.2-0.2-1-0.2-0.2-0.2 0.1-0.6 0.1-0.4 0-0.7-0.1-0.5-0.3-0.3-0.5-0.1-0.7 0-1.1-0.1-1.8 0-1.5 0.3-1.8 0.1-0.9 0.2-0.6 0.4-0.9 2.7-3.9 2-1.7 0.4-0.4 0.7-1.1 0.6-1.1 0.1-0.5 0.1-0.4 0-0.4-0.1-0.6-0.4-0.9-0.1-0.5-0.1-0.6 0-0.6 0.1-0.5 0.1-0.3 0.2-0.3 0.4-0.3 0.8-0.5 0.2-0.2-0.1-0.2-0.3-0.1-5-0.3-0.7-0.4-0.3-1.6 7.6 0.1 4.6-0.9 11.9 2.2 3.8-0.1 1.7 0.4 3.5 3.2 1.7 0.7 12.9 0.5 0.6 0.4 0 0.6-0.1 0.8 0.1 0.9 0.7 1 0.8 0.4 12.2 0.4 10.4 4.6 3.7 0.6 5.6-1.9 4.8 0.1 2.3 0.6 1.5 0.8 0.4 1.4-0.2 1.9 0 2.4 0.4 1.8 0.8 0.7 1.1 0.2 1.4-0.2 1.6 0.4-0.1 1.5-0.9 2-0.3 1.5 0.8 0.7 1.2-0.2 2.2-0.7 3.3 0.6 1.1-0.1 1.2-0.6 1.6-1.7 1-0.6 1.9 0.1 6 2.4 3.1 0.1 0.9 0.5 0.7 1.9-0.4 2.2-1.9 4.5 0.1 0.2z"
-        id="16" name="Rivne" fill="{{ if (index .alerts 16) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="16" name="Rivne" fill="{{ if (index .alerts "16") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M236.2 336.3l1.3-1.5 1 0.4 2.6-0.7 1.1 0.6 1.4 1.4 0.4 0.6 1 2.4 0.7 1.1 0.8 0.7 0.9 0.3 1.2 0.1 0.9-0.5-0.8-2.9 0.5-1.2 0.6-0.1 0.4 0.4 0.3 0.6 0.2 0.3 0.6 0.1 1.9-0.1 1.1-0.3-0.2-0.9-0.9-0.9-1-0.7-2-0.6-1.1-0.6-0.1-0.9 1.1-0.9 1.3 0.3 2 1.5 0.8 0.4 0.9 0.1 0.6-0.5 0.3-1.4-0.2-0.5-1.1-1.2-0.2-0.6 0.3-0.8 0.7-0.1 0.6 0.6 0.3 0.8 0.2 1.4 0.5 1 0.8-0.1 0.8-1.4 0.1-0.9-0.4-1.2 0.3-0.7 0.6-0.5 0.5-0.1 0.6 0.2 0.6 0.4-0.4 1.7 0.3 1.7 0.8 1.2 1 0.5 1.4-0.2 0.8-0.7 0.6-1 0.8-1 1.1-0.5 1.2 0 2.1 0.5 0.7 0.9 0.4 0.3 0.2-0.1 0.2-0.3 0.3-0.2 0.7 0.1 1.1 0.4 2.4 0.2 0.6-0.1 0.3-0.4 0.7-1.1 0.3-0.3 1.9 0 0.6 0.2 1.2 0.8 0.7 0.2 0.6-0.1 0.6-0.1 0.4-0.3 0.3-0.7 0-1.1-0.3-0.4-0.6-0.1-0.5-0.3-0.1-1.1 1.2-0.2 2.2 0.4 0.5 0.4 0.5 0.5 0.5 0.4 0.7-0.1 0.6-0.5 0.7-1.4 0.7-0.5 2.1 0.8 1.6 1.5 1.1 2.1 0.8 2.5 1.2 5.5 0.6 0.8-1.3 1.8-3.4 2.8-0.9 0.5-0.8-0.3-1.5-1.5-1.1-0.3-2 1.1-3.3 4.1-2.1 1.1-1.8-0.1-5.9-2.2-1.2-0.8-0.6-0.1-0.6 0.2 0 0.6 0.1 0.7 0 0.5 0 0.4 0.2 0.4 0.1 0.5-0.3 0.2-0.7-0.2-0.6 0.1-0.4-0.2-0.4-0.1-0.5 0.5-2.1 0.4-1.9-1.2-1.8-1.5-1.8-0.8-0.6 0.2-0.2 0.5-0.2 0.6-0.5 0.5-0.4 0-0.9-0.4-0.3 0-0.6 0.9 0.1 0.7 0.3 1 0.3 1.6 0 1.6-0.2 1.1-0.6 0.5-1.1 0.1-1.1-0.4-0.6-0.9-0.6-1.1-0.8-0.8-1.1-0.4-0.3 0.7-0.3 1-1.8 1.2-0.6 1-0.4 1.3 0 0.7-1.7 0.8-7 1.6-0.9 0.2-2.6 0.1-0.9 0.3-1.9 0.9-0.9 0.3-1-0.1-0.5-0.2-0.4 2.7 0.1 1.9-0.6 2.6-0.9 2.6-0.9 1.8-0.6 0.8-1.5 1.2-0.7 0.9-1.2 2.8-0.5 0.9-2.6 1.2-5.4 0-3.5 1.1-2.6-0.3-1 0.2-1.6 0.7-3 0.4-3.6 1.5-27.2 3-2.3 1.6-5.4 9-2.3 2.2-3.5 1.5-4.9 0.9 0-1 0-0.4 0.2-1.9 0.1-0.3 2.6-5.8 0.2-0.7 0-0.7-0.1-0.6-0.1-0.2-0.5-1.1-0.2-0.8 0-1.8-0.1-0.6-0.2-0.5-0.9-1-0.2-0.5-0.5-1.4-0.1-0.6 0-1.3-0.3-1.6 0.1-0.6 0.1-0.7 0.2-0.6 2.5-4.8 0.3-0.6 0.2-1.5 0-0.3 0.3-0.4 0.4-0.5 0.9-0.6 0.6-0.3 1-0.3 0.4-0.3 2.9-3.4 0.6-0.4 0.5-0.3 0.6-0.1 0.2-0.2 0.1-0.4-0.1-0.3-0.3-0.8-0.1-0.6 0-1.1 0.2-0.6 0.2-0.4 0.4-0.4 0.8-0.6 0.8-0.4 0.6-0.3 0.5-0.3 5.3-7 4.3-3.3 2-0.9 2.3-0.8 2.5-0.3 1.1 0.1 0.7 0.2 1.1 0.4 0.6 0.3 0.4 0.3 0.7 0 0.3-0.1 0.2-0.3 0.3-0.5 0.2-0.6 0.4-2.2 0.3-7.4-0.1-0.9-1.5-4.1-0.6-2.3-0.1-1 0-0.4 0.1-0.4 0.3-0.7 0.3-0.4 0.5-0.6 0.7-0.7 2.1-1.6 0.7 0.5 1.2 0.4 2.4 0.2 0.5 0.5 0.4 2 0.6 0.4 0.6-0.2 0.3-0.6 0.1-0.8 0.4-0.7 1.5-1 0.8 0.7 0.7 1.3 1 0.8 1.1 1.5 0.2 0.5 0 0.7 0 0.3 2.7 1 0.9 0.1 0.9-0.1 0.9-0.7 0.7-1.6 0.7-0.6 1.4 0.6 0.5 0 0.3-0.5 0.2-1.3 0.2-0.5 0.9-0.4 0.5 0.5 0.2 1.2 0 1-0.5 2.7 0 1 0.6 1.2 0.9 0.8 1.1 0.6 1-0.1 0.7-1.1-0.9-1.3-0.4-0.9-0.2-0.9 0-1.2 0.1-0.4 1.6 0.2 0.7 1-0.1 2.4 0.3 2.4 1.7 1.1 3.2-0.7 1 0.1 0.7 0.3 1.4 0.8 1.8 0.7 1.5 0.1 1.5-0.5z"
-        id="23" name="Chernivtsi" fill="{{ if (index .alerts 23) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="23" name="Chernivtsi" fill="{{ if (index .alerts "23") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M143.5 254l4.5 7 0.9 1.7 1 2.8 0.2 0.6 0.4 0.8 0 0.4-0.1 0.3-0.3 0.5-0.1 0.7 0 0.3 0.1 0.6 0.1 0.3 0.2 0.6 0.2 1.6 0.1 1 0.1 0.3 0.1 0.3 0.3 0.3 1.1 0.4 0.3 0.4 0.2 0.6 0.2 0.8 0.2 0.9 0 0.7-0.1 0.7-0.2 0.6-0.2 0.2-0.8 0.7-0.3 0.5-0.1 0.6 0 0.2 0.4 1.1 0.1 0.4 0 0.7-0.1 0.7-0.1 0.7 0.2 0.6 0.3 0.5 0.3 0.2 0.5 0 0.3-0.1 0.9-0.7 0.6-0.2 0.2 0 1.1 0.5 1.7 0.3 0.3 0.3 0.2 0.3 0.3 0.6 0.1 0.4 0.1 0.5 0 0.7 0.1 0.6 0.3 0.5 0 0.6 0 0.3-0.4 0.4-0.2 0.2-0.5 0.2-0.3-0.1-0.6-0.1-1.6-0.9-0.7-0.1-0.6 0-0.3 0.2-0.1 0.2-0.1 0.3 0 0.6 0.2 0.5 0.6 0.5 1.8 0.8 0.5 0.3 0.3 0.6 0.2 1.1 0.2 0.7 0.8 1.3 0.8 0.7 1 0 2.3-0.4 0.8 0.1 3 1.8 0.5 0.6 0 0.8-0.4 1.4 0 0.9 0.4 0.8 1.3 2.1 0.7 0.6 0.4-0.5 0.3-1 0.1-1 0.2-0.7 0.3-0.6 0.5-0.6 1.2-1 0.6-0.2 0.8 0.1 0 0.6-0.5 1-0.5 2.1-0.3 2.3 0.2 1.5 1.4-1.5 1.3 0.1 3 1.4-1.2 1.2-3.1 0.9-0.6 1.4 0.6 2.1 1.5-0.5 2.8-2.8 0.8-0.1 0.5 0.2 0.1 0 0.1-1.2-0.1-1.1-0.1-0.7 0.2-0.5 1.2-0.1 0.7 0.2 0.6 0.4 0.8 0.2 0.9-0.2 0.1-0.4-0.1-0.6 0-0.5 0.5-0.2 0.4 0.1 0.8 0.4 0.5 0.1-0.5 1.2-0.2 0.5-0.5 0.5 0.7 0.9 1.1 1 1.3 0.7 1 0.4 1.4 0.1 0.9 0.5 1.7 1.6 1.5 0.9 0.4 0.3 0.6 1.3-0.1 0.5-0.3 0.2-0.2 0.6-0.1 0.7 0 0.2 0.1 0.1 0.6 0.7 0.3 1-0.2 0.6-0.3 0.5 0 0.5-2.1 1.6-0.7 0.7-0.5 0.6-0.3 0.4-0.3 0.7-0.1 0.4 0 0.4 0.1 1 0.6 2.3 1.5 4.1 0.1 0.9-0.3 7.4-0.4 2.2-0.2 0.6-0.3 0.5-0.2 0.3-0.3 0.1-0.7 0-0.4-0.3-0.6-0.3-1.1-0.4-0.7-0.2-1.1-0.1-2.5 0.3-2.3 0.8-2 0.9-4.3 3.3-5.3 7-0.5 0.3-0.6 0.3-0.8 0.4-0.8 0.6-0.4 0.4-0.2 0.4-0.2 0.6 0 1.1 0.1 0.6 0.3 0.8 0.1 0.3-0.1 0.4-0.2 0.2-0.6 0.1-0.5 0.3-0.6 0.4-2.9 3.4-0.4 0.3-1 0.3-0.6 0.3-0.9 0.6-0.4 0.5-0.3 0.4 0 0.3-0.2 1.5-0.3 0.6-2.5 4.8-0.2 0.6-0.1 0.7-0.1 0.6 0.3 1.6 0 1.3 0.1 0.6 0.5 1.4 0.2 0.5 0.9 1 0.2 0.5 0.1 0.6 0 1.8 0.2 0.8 0.5 1.1 0.1 0.2 0.1 0.6 0 0.7-0.2 0.7-2.6 5.8-0.1 0.3-0.2 1.9 0 0.4 0 1-1.8 0.3-1-0.7-1.3-2-1.9-3.4-0.7-0.9-0.8-0.8-4.5-1.7-1.8-1.2-1-1.1-0.3-1.1 0-1-0.4-1.3-0.8-1.1-1.4-1.2-2.2-1.2 0.2-1 0.5-2.9 0.3-1 2.9-4.3 0.1-0.4-0.1-0.3-1.8-2.7-3.8-3.8-0.4-0.5-0.2-0.4-0.3-0.8-0.1-0.5 0-0.4 0-0.4 0.2-0.6 0.3-0.5 0.3-0.5 0.3-0.7 0.2-0.5-0.1-0.4-0.1-0.3-1.1-1.8-1-2.1-0.4-0.5-3.6-3.4-1.1-0.8-0.6-0.6-0.5-0.6-0.3-0.3-0.4-0.2-1.4 0-0.2 0-0.2-0.3-0.1-1-0.2-1-0.2-0.5-0.3-0.4-0.6-0.5-0.4-0.3-0.4-0.3-0.2 0.1-0.2 0.2-0.3 0.8-0.5 0.8-0.3 0.4-0.7 0.5-0.5 0.2-1.3 0.2-1.4 0-1.7-0.4-0.4-0.2-0.4-0.3-0.7-0.7-0.3-0.5-0.2-0.4-0.1-0.3-0.4-2.5-0.1-1 0-0.7 0.4-2.1 0.1-0.3 0.1-0.7 0-0.3-0.1-0.6-0.2-0.6-0.1-0.9 0.1-0.5 0.1-0.8 0-0.4 0-0.6-0.2-0.3-0.3-0.2-0.3 0-0.5 0.1-2.7 1.8-1.1 0.5-2.4 0.4-1.2 0.8-0.9 1-0.2 0.2-0.5 0.3-0.5 0.2-0.4 0-0.4-0.1-0.6-0.3-0.3-0.2-0.2-0.3-0.1-0.3 0.1-0.7 0.1-0.6 0.2-0.6 0.2-0.7-0.1-0.5-0.1-0.5-0.4-0.9-0.2-0.4-0.5-0.6-0.4-0.3-0.5-0.2-0.7-0.1-0.5-0.2-0.5-0.3-3.1-2.6-0.3-0.4-0.2-0.4-0.1-0.6 0.2-1.1 0-0.5-0.2-0.7-0.2-0.3-0.3-0.2-0.6-0.2-0.7-0.1-0.7 0-0.6 0.1-0.8 0.3-0.5 0-0.4-0.1-1-0.8-3.5-4.2-2.1-1-2.3-0.6 2.4-9.3-0.1-0.7-0.1-0.9-0.2-0.6-0.3-0.5-0.6-0.8-0.2-0.4 0-0.4 0-0.3 0.2-0.6 1.3-6.2 0.3-0.7 0.9-0.6 1.2-1.1 0.2-0.2 1-0.9 0.4-0.7 0.4-1 1.4-4.4 0.2-0.3 0.5-0.7 1-0.8 0.9-0.6 0.6-0.2 0.9-0.1 5.5 0.8 2.8-0.2 0.4-0.1 0.5-0.3 1.2-1.1 0.4-0.2 0.3 0 0.7 0.1 2 0.8 0.4-0.1 0.7-0.3 2.5-1.8 0.5-0.2 0.3 0 0.7 0.1 2.4 0.8 0.4 0 0.6 0 2.6-1.2 0.6-0.1 1.4 0.1 1.3-0.3 0.5-0.2 2.4-1.4 2.2-0.9 0.6-0.1 0.3 0 0.7 0.1 0.2 0.1 0.4 0.1 0.5-0.1 1.1-0.4 0.5-0.4 0.4-0.3 0.3-0.6 0-0.6-0.1-0.3-1.1-1.4-0.4-0.6-0.7 0.4-1-0.1-1.3-0.4-0.4-0.4-0.1-2 0-0.4 0.1-0.3 0.4-0.9 0.1-0.4 0.1-0.3-0.1-0.3-0.3-0.4-0.5-0.3-0.3-0.1-0.3 0.1-0.3 0.1-1.2 1.1-0.5 0.3-0.2 0-0.3 0-0.3-0.1-0.3-0.5-0.1-0.3 0-0.4 0.1-0.6 0.2-0.3 0.2-0.2 1.1-0.4 0.6-0.1 1.2 0 0.8-0.2 0.6-0.3 0.4-0.4 0.5-0.8 1.8-1.9 0.3-0.4 0.1-0.3-0.1-0.3-0.3-0.4-1.7-1.1-0.2-0.2 0.1-0.5 0.3-0.9 1.7-2.7 0.3-0.6 0.1-0.7-0.2-0.6-0.2-0.6-0.4-0.7-0.1-0.3 0.1-0.5 0.2-0.5 0.5-1 0.4-0.5 0.3-0.3 0.2-0.1 0.4 0 1.9 0.5 0.7 0 4.2-1.1 0.6 0 0.7 0.2 0.5 0.2 0.5 0.3 1.3 1.1 0.2 0.2 0.4 0.3 0.3 0 0.5-0.1 0.5 0.2 0.5 0.3 0.6 0.1 0.8 0z"
-        id="8" name="Ivano-Frankivs'k" fill="{{ if (index .alerts 8) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="8" name="Ivano-Frankivs'k" fill="{{ if (index .alerts "8") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M281.1 163.5l0.8 0.4 0.3 0.3 0.7 1.1 0.8 0.6 0.4 0.3 0.3 0.8 0.2 0.5 0.3 0.4 0.4 0.4 0.4 0.1 1.1 0.3 0.5 0.3 0 0.3-0.4 0.4-1.2 0.7-0.5 0.3-0.4 0.4-0.3 0.5-0.3 0.6-1.1 4 0 0.7 0.1 0.4 0.3 0.2 0.5 0.4 0.4 0.1 1.5 0.3 0.5 0.3 0.2 0.2 0.2 0.4 0.2 1 0.2 0.4 0.3 0.4 0.6 0.5 2.4 1.4 1.8 1.4 0.5 0.3 0.2 0.2 0.1 0.3-0.2 0.3-0.3 0.5-0.1 0.3 0.1 0.3 0.2 0.2 0.4 0 0.6-0.1 0.3 0.1 0.3 0.2 0.3 0.7 0.3 0.4 0.5 0.3 1.1 0.3 0.5 0.4 0.1 0.3 0.1 0.3 0.1 1.1 0.1 0.4 0.4 0.2 0.2 0 2.5 0.3 0.3-0.1 0.2-0.1 0.3-0.5 0.4-1 0.5-0.7 0.4-0.8 0.2-0.2 0.4 0 0.3 0 0.3 0.3 0.2 0.4 0.1 0.7 0 0.6-0.2 2 0 0.4 0.1 0.3 0.2 0.4 0.4 0.6 0.9 1 0.6 0.9 0.2 0.7 0 0.7-0.1 0.4-0.3 0.2-0.5 0.3-0.2 0.1-0.1 0.3 0 0.3 0.1 0.4 0.7 0.6 0.2 0.3 0.1 0.2-0.1 0.4-0.7 0.9-0.3 0.6-0.3 0.9-0.1 0.5 0.1 0.3 0.2 0.3 0.4 0.3 1.1 0.5 0.2 0.1 0.2 0.3 0.2 0.4 0.1 0.8-0.1 1-0.2 0.7-0.2 0.2-0.9 0.1-0.3 0.2-0.4 0.4-0.3 0.4-0.4 0.4-0.6 0.1-0.4 0.1-2.7-0.5-0.2 0.1 0 0.3 0.1 0.5 1.6 2.8 0.2 0.6 0.1 0.6 0 0.2-0.2 0.2-0.3 0.1-1 0.1-0.3 0.1-0.1 0.2-0.1 0.3-0.2 1.9 0.2 0.4 0.4 0.3 2 0.8 0.3 0.2 0.2 0.2 0.2 0.5 0 0.3-0.2 0.9 0.1 0.6 0.3 0.5 1.5 2 0.6 0.9 0.2 0.6 0.1 0.6 0.1 0.3 0.3 0.5 0.3 0.4 11.5 3.3-1 1.3-0.7 0.2-1.1 0.1-0.2 0.1-0.1 0.1 0.2 0.2 1 0.7 0.2 0.3 0 0.3-0.4 0.6-0.4 0.5-0.5 0.4-0.5 0.3-0.3 0-1.4-0.3-0.3 0-0.2 0.1-0.1 0.3-0.6 1.1-0.1 0.3 0 0.4 0.1 0.4 0.2 0.3 0.2 0.2 1.4 0.7 0.5 0.3 0.4 0.4 0.4 0.4 0.2 0.5 0.2 0.6 0 0.3-0.1 0.5-0.2 0.6-1.7 3.4-0.5 0.7-0.2 0.3 0 0.3 0.1 0.3 0.2 0.3 0.6 0.6 0.2 0.2 0 0.4-0.1 0.5-0.5 0.7-0.4 0.3-0.4 0-0.5-0.3-0.3-0.1-0.6 0.1-0.2 0.1-0.2 0.4-0.2 0.6 0 1.3 0.1 0.5 0.1 0.4 1.6 1.2 0.2 0.2 0.1 0.3 0 0.2-0.3 0.3-0.7 0.5-0.2 0.2-0.1 0.3-0.1 0.4 0.1 0.6 0.2 0.3 0.2 0.2 0.3 0.2 0.7 0.1 3.4 0 0.2 0.2 0.3 0.5 0.2 0.5 0.4 1.1 0 0.3-0.1 0.3-0.3 0.2-1.7 0.6-0.2 0.3-0.1 0.4 0.1 0.5 0.2 0.3 0.2 0.6 0.1 0.3 0.1 0.9 0.2 0.6 0.5 0.3 0.9 0.2 0.2 0.2 0.2 0.2 0.1 0.2 0 0.4-0.1 0.8 0 0.8 0.2 0.5 0.2 0.2 0.7 0.9 0.3 0.7 0 0.4-0.2 0.4-0.4 0.6-0.3 0.5-0.2 0.5-0.1 0.6 0.1 0.8 0.3 0.5 0.6 0.9 0.3 0.5 0.1 0.6 0 0.7 0 0.7 0.1 0.6 0.1 0.6 0.2 0.6 0.1 0.2-0.1 0.4-0.2 0.3-0.6 0.3-0.4 0.1-1.8 0.1-1.3 0.6-0.4 0.4-1.1 1.4-0.3 0.3-0.6 0.1-0.6-0.1-0.6-0.3-0.5-0.3-1.1-0.8-0.6-0.3-1-0.1-0.3-0.1-0.6-0.4-0.9-0.6-0.8-0.4-1.4-0.3-0.3 0.2-0.4 0.4-1.2 2.2-0.4 0.3-0.3 0.1-2.4-0.4-1.3 0-0.3 0.3-0.4 0.4-0.4 1.1-0.2 0.6-0.2 0.5-0.1 0.3 0 0.7-0.1 0.4-0.3 0.4-0.9 0.4-0.2 0.2-0.3 0.5-0.2 0.9-0.3 0.7-0.4 0.4-0.4 0.3-0.6 0.6-0.4 0.2-0.2 0.3-0.1 0.4-0.3 1.1-0.3 0.3-0.1 0.4 0 0.4 0.2 0.8 0.2 0.4 0.5 0.8 0.1 0.2 0 0.3-0.1 0.4-0.3 0.4-0.3 0.2-0.5 0.3-0.2 0.2-0.1 0.4 0.1 0.4 0.2 0.9 0.6 1 0.4 1.4 0.2 1.2 0.1 0.6 0.2 4.6 0.2 1.1 0.4 1.1 0.3 0.5 0.3 0.5 0 0.3 0 0.3-0.3 0.3-0.3 0-0.6 0-0.3 0.1-0.1 0.3 0 0.8 0.1 0.9 0.2 0.4 0 0.4-0.1 1.9-0.4 2.1-1.2 4.5-0.7 0.5-0.7 1.4-0.6 0.5-0.7 0.1-0.5-0.4-0.5-0.5-0.5-0.4-2.2-0.4-1.2 0.2 0.1 1.1 0.5 0.3 0.6 0.1 0.3 0.4 0 1.1-0.3 0.7-0.4 0.3-0.6 0.1-0.6 0.1-0.7-0.2-1.2-0.8-0.6-0.2-1.9 0-0.3 0.3-0.7 1.1-0.3 0.4-0.6 0.1-2.4-0.2-1.1-0.4-0.7-0.1-0.3 0.2-0.2 0.3-0.2 0.1-0.4-0.3-0.7-0.9-2.1-0.5-1.2 0-1.1 0.5-0.8 1-0.6 1-0.8 0.7-1.4 0.2-1-0.5-0.8-1.2-0.3-1.7 0.4-1.7-0.6-0.4-0.6-0.2-0.5 0.1-0.6 0.5-0.3 0.7 0.4 1.2-0.1 0.9-0.8 1.4-0.8 0.1-0.5-1-0.2-1.4-0.3-0.8-0.6-0.6-0.7 0.1-0.3 0.8 0.2 0.6 1.1 1.2 0.2 0.5-0.3 1.4-0.6 0.5-0.9-0.1-0.8-0.4-2-1.5-1.3-0.3-1.1 0.9 0.1 0.9 1.1 0.6 2 0.6 1 0.7 0.9 0.9 0.2 0.9-1.1 0.3-1.9 0.1-0.6-0.1-0.2-0.3-0.3-0.6-0.4-0.4-0.6 0.1-0.5 1.2 0.8 2.9-0.9 0.5-1.2-0.1-0.9-0.3-0.8-0.7-0.7-1.1-1-2.4-0.4-0.6-1.4-1.4-1.1-0.6-2.6 0.7-1-0.4-1.3 1.5-0.9-2-0.4-1.1-0.2-0.7 0.1-0.3 0.5-0.2 0.2-0.1 0.2-0.4-0.2-0.2-0.7-0.4-0.5-0.5-0.3-0.2-1.1-0.7-0.4-0.2-0.4-0.4-0.1-0.4 0-0.3 0.1-0.3 0.4-0.8 0.1-0.5 0-0.9-0.2-0.1-0.1 0.1-0.4 0.4-0.2 0.2-0.3-0.1-0.5-0.3-0.2-0.4 0-0.3 0.1-0.8-0.1-0.2-0.3-0.1-0.3-0.1-0.5-0.1-0.4-0.2-0.7-0.6-0.3-0.5-0.1-0.4 0.1-0.7 0.3-0.9 0.1-0.7 0-1.7 0.1-0.6 0.2-0.6 0.1-0.5-0.1-0.3-0.3-0.4-0.7-0.8-0.1-0.4-0.1-0.3 0.2-0.3 0.4-0.7 0.1-0.6 0.1-0.2 0.2-0.1 0.6-0.1 0.3-0.2 0.1-0.3 0-0.2-0.3-0.2-1.1-0.4-0.4-0.4-0.8-1.2-0.1-0.4 0-0.4 0.2-2.7 0.2-1.1 0-0.4-0.1-0.3-0.2-0.2-0.8 0.2-0.4 0-0.1-0.2 0-0.3 0-0.3 0.4-0.9 0.1-0.5 0-0.7-0.1-0.5-0.2-0.3-0.4-0.7-0.1-0.4 0.2-0.2 0.5-0.3 0.2-0.2 0.1-0.4-0.1-0.5-0.3-0.7 0-0.3 0.2-0.2 0.7-0.2 0.3-0.6 0-0.1-0.1-0.5-0.6-1-0.4-0.9-0.1-0.6 0-0.4 0.3-0.5 0.4-0.5 0.5-0.5 0.3-0.3 0.1-0.4 0.2-0.6 0-0.4-0.1-0.6-0.9-1.9-0.1-0.5 0-0.4 0.1-0.7 0-0.4 0.4-0.9 0.1-0.4-0.1-0.3-0.1-0.3-0.6-0.7-0.2-0.3-0.3-0.7 0-0.4 0-0.5 0.3-1.3 0-0.8 0-0.7 0.1-0.4 0.1-0.3 0.2-0.3 0.4-0.4 0.5-0.2 0.8-0.3 0.2-0.3 0.1-0.4 0-0.9 0-0.5 0.1-0.4 0.3-0.7 0.2-0.6 0-0.4-0.1-0.3-0.6-0.9-0.4-0.7-0.2-0.6-0.1-0.4 0.1-0.5 0.2-1 0-0.4 0-0.5-0.4-1.6-0.1-0.8 0-0.9 0-0.5-0.1-0.3-0.2-0.2-1.4-1-0.2-0.3-0.2-0.5-0.5-2.3-0.5-1.5-1-2.1-0.3-1-0.1-0.5 0-0.4 0.1-0.5 0.8-1.9 0.2-0.4 0.4-0.3 1.5-0.8 0.3-0.5 0.2-0.3 0.2-1 0.2-2.2 0.1-0.7 0.2-0.6 0.4-0.4 0.6-0.6 0.2-0.3 0.2-0.4 0.2-0.7 0-0.5 0-0.4-0.4-0.4-1.5-1.3-0.4-0.6-0.3-0.6-0.1-0.5 0-0.4 0.1-0.3 0.2-0.5 0.4-0.5 0.6-0.6 0.2-0.5 0.1-0.7 0.1-1.7 0-0.8-0.1-0.6-0.1-0.3-0.2-0.5-0.3-0.5-1.3-1-0.4-0.7-0.3-0.7 0-0.4 0.1-0.4 0.2-1 0.1-1 0-0.5 0-0.5-0.3-0.5-0.4-0.4-0.7-0.6-0.3-0.3-0.3-0.6-0.1-0.4 0-0.4 0.4-1 0.2-0.6-0.1-0.7-0.2-0.9-0.5-1.4-0.1-0.7 0.3-0.4 0.4-0.4 0.4-0.4 3.2-1 0.2-0.3 0.1-0.4 0.1-0.7-0.1-0.4-0.3-0.2-0.7 0-0.4-0.2-0.3-0.2-0.3-0.4-0.1-0.3 0.1-0.3 0.2-0.1 1-0.6 0.2-0.2 0.3-0.6 0.2-1 0-0.4-0.2-0.2-0.7-0.6-0.4-0.5-0.2-0.3 0.1-0.3 0.1-0.3 0.2-0.1 2.1-0.2 0.6-0.1 0.3-0.2 0.2-0.4 0.2-0.7 0-0.4-0.1-0.3-0.3-0.5-0.4-0.4-0.5-0.3-1.9-0.6-0.3-0.1-0.2-0.2 0.3-0.3 1.2-0.6 2.7-1.3 1.2-0.8 0.9-1.1 2.3-3.6 0.4-0.4 0.8-0.8 0.4-0.2 0.5-0.2 0.7-0.1 0.5 0 0.7 0.2 0.4 0.1 1.3-0.2 0.4-0.1 0.5-0.3 0.9-1 0.4-0.4 0.6-1 0.2-0.2 2-1.5 0.5-0.4 0.3-0.4 0.4-0.9 0.5-0.8 0.3-0.4 2.6-1.9 0.3-0.3 0.2-0.5 0.1-0.5 0-0.6 0-0.3 0.1-0.3 2.4-1.9 0.5-0.6 0.3-0.6 0-0.3 0.2-0.4 0.3-0.4 0.6-0.5 0.2-0.4 0.2-0.5 0-0.7 0.1-0.4 0.2-0.3 0.3-0.1 0.6 0.1 1.3 0.5 0.3 0.1 0.3-0.1 0.3-0.2 0.2-0.3 0-0.4-0.1-1 0-0.7 0.2-0.6 0.3-0.4 3.8-1.6 1.1-1 0.3-0.1 0.4 0 1.1 0.5 1.1 0.6 0.7 0.2 0.4 0 0.5-0.2 0.5-0.4 0.3-0.3 0.4-0.5 0.9-0.8 0.4-0.3 1.1-0.5 1.2-0.3 0.5-0.3 0.1-0.3 0.3-1 0.2-0.6 0.4-0.4 0.4-0.2 0.8 0 0.4 0.2 0.3 0.2 0.3 0.7 0.1 0.5 0 0.4 0 0.8 0.3 0.3 0.3 0.3 2.7 0.6z"
-        id="21" name="Khmel'nyts'kyy" fill="{{ if (index .alerts 21) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="21" name="Khmel'nyts'kyy" fill="{{ if (index .alerts "21") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M163.2 184.9l0.4 0.9 0.8 0.4 1.1 0.1 0.7-0.1 0.5-0.2 1.5-0.9 0.6-0.1 0.3-0.1 0.4 0.1 0.6 0.2 0.5 0.3 0.2 0.4 0.2 0.6 0.2 1.1 0.1 0.7-0.1 0.5-0.2 0.5-0.4 0.5-1.6 1.6-0.1 0.2 0 0.4 0.2 0.6 1.1 1.2 0.2 0.5 0 0.4-0.4 2.1-0.1 1.5 0.1 1.1 0.4 2.1 0.5 0.7 5.2 4.6 4 2.5 0 0.6 0.1 0.5 0.2 0.3 0.3 0.4 0.2 0.2 1.2 0.8 0.3 0.5 0.2 0.5 0.2 0.3 0.6 0.9 0.3 0.5 0.1 0.6-0.1 0.3-0.3 0.3-0.5 0.1-0.8 0.1-0.3 0.2-0.3 0.3-0.3 0.7 0 0.4 0 0.4 0.3 0.5 0.1 0.6 0.1 0.6-0.1 0.3-0.3 0.5-0.3 0.3-0.5 0.4-0.9 0.5-0.6 0.1-0.8 0.1-0.3 0.1-1.1 0.7-0.3 0.2-1.2 0.3-2 1.1-0.3 0.1-4.1 0.8-0.5 0.2-0.6 0.2-2.6 0.3-0.3 0.1-0.3 0.3-0.2 0.6-0.3 1.5 0 0.7 0 0.3 0.4 0.8 0.9 1.4 0.2 0.3 0.1 0.6 0 0.3-0.1 0.7-0.3 0.3-0.3 0.4-1.2 0.7-0.4 0.1-0.5 0.1-0.6-0.1-0.3-0.1-0.5-0.6-0.3 0-0.3 0.2-0.3 0.3-1.2 2.4-0.3 0.9-0.4 0.9-1.5 2.9-0.3 0.3-9.6 5.4-0.5 0.2-0.5 0-0.3-0.1-0.7 0-0.3 0.2-0.3 0.5-0.3 2.7-0.2 1.1-0.7 1.6-0.8 0-0.6-0.1-0.5-0.3-0.5-0.2-0.5 0.1-0.3 0-0.4-0.3-0.2-0.2-1.3-1.1-0.5-0.3-0.5-0.2-0.7-0.2-0.6 0-4.2 1.1-0.7 0-1.9-0.5-0.4 0-0.2 0.1-0.3 0.3-0.4 0.5-0.5 1-0.2 0.5-0.1 0.5 0.1 0.3 0.4 0.7 0.2 0.6 0.2 0.6-0.1 0.7-0.3 0.6-1.7 2.7-0.3 0.9-0.1 0.5 0.2 0.2 1.7 1.1 0.3 0.4 0.1 0.3-0.1 0.3-0.3 0.4-1.8 1.9-0.5 0.8-0.4 0.4-0.6 0.3-0.8 0.2-1.2 0-0.6 0.1-1.1 0.4-0.2 0.2-0.2 0.3-0.1 0.6 0 0.4 0.1 0.3 0.3 0.5 0.3 0.1 0.3 0 0.2 0 0.5-0.3 1.2-1.1 0.3-0.1 0.3-0.1 0.3 0.1 0.5 0.3 0.3 0.4 0.1 0.3-0.1 0.3-0.1 0.4-0.4 0.9-0.1 0.3 0 0.4 0.1 2 0.4 0.4 1.3 0.4 1 0.1 0.7-0.4 0.4 0.6 1.1 1.4 0.1 0.3 0 0.6-0.3 0.6-0.4 0.3-0.5 0.4-1.1 0.4-0.5 0.1-0.4-0.1-0.2-0.1-0.7-0.1-0.3 0-0.6 0.1-2.2 0.9-2.4 1.4-0.5 0.2-1.3 0.3-1.4-0.1-0.6 0.1-2.6 1.2-0.6 0-0.4 0-2.4-0.8-0.7-0.1-0.3 0-0.5 0.2-2.5 1.8-0.7 0.3-0.4 0.1-2-0.8-0.7-0.1-0.3 0-0.4 0.2-1.2 1.1-0.5 0.3-0.4 0.1-2.8 0.2-5.5-0.8-0.9 0.1-0.6 0.2-0.9 0.6-1 0.8-0.5 0.7-0.2 0.3-1.4 4.4-0.4 1-0.4 0.7-1 0.9-0.2 0.2-1.2 1.1-0.9 0.6-0.3 0.7-1.3 6.2-0.2 0.6 0 0.3 0 0.4 0.2 0.4 0.6 0.8 0.3 0.5 0.2 0.6 0.1 0.9 0.1 0.7-2.4 9.3-1.3-0.6-0.6-0.1-2.9 0.4-1.1-0.1-1.6-0.3-0.6-0.2-0.5-0.3-0.1-0.2-0.2-0.5-0.1-0.3 0-0.5 0-0.4-0.2-0.4-0.5-0.5-0.4-0.1-0.3 0.1-0.2 0.2-0.8 1.1-0.3 0.2-0.4 0.2-0.3 0-0.3-0.4-0.1-0.5 0-1-0.2-0.7-0.2 0-0.2 0-0.5 0.6-0.6 0.1-0.7 0.1-2.6-0.3-0.5-0.1-0.5-0.4-0.4-0.3-0.3-0.4-1.1-2-1.3-3.3-0.4-0.7-0.6-0.5-0.4-0.3-0.4-0.1-1.5 0.3-2.3 0.7-0.5 0.3-0.4 0.1-0.4 0-0.9 0-0.4-0.1-0.4-0.2-0.4-0.7-0.2-0.6-0.2-0.4-0.3-0.5-2-1.6-1.4-1.7-0.3-0.4-0.2-0.6-0.1-0.9 0-0.3 0-1.1 0.2-0.6 0.5-1.1-0.1-0.7-1-1.2-1-0.6 0-0.2-0.9-1.6-0.3-0.8 0.1-1.1 0.5-1.7 0-0.7-0.7-0.8-2.5-1.4-1-0.8-1.6-2.1-1.8-1.3 0.3-0.1-0.9-0.6-0.7 0.9-0.3 0.2-0.3-0.4 0.3-1.1 0.9-1.8 0.7-3 1-2.6 0.2-1.2-0.8-7.8-0.2-1.4-0.3-0.6-1.2-1.3-0.7-1.1-0.2-0.5-0.2-1-0.3-3.6-0.4-1.2-1.1-3.1 1.4-3.3 4.2-5.7 1-2.7 0.4-0.7 0.6-0.6 1.2-0.2 0.6-0.3 0.9-0.9 3.4-6.2 0.6-0.7 0.4-0.3 1-0.4 0.5-0.4 0.3-0.6 0.4-1.8 0.3-0.7 2.3-2.4 6-8.8 2.2-2.5 2.1-1.5 1.6-2.6 12.7-13.8 2.5-2 3-2.3 1.6-1.3 4.4-4.7 0.7-1.2 0.8-2.4 0.6-1.3 0.7-0.7 1-0.5 1.9-0.6 10-0.1 2.9-1.2 1.2-2.9 0.3-0.9 0.1-1.2-0.1-1.6 0.2-1.1 3.5-1.9 1.1-1.1 0.7-1 0-0.2-0.3-0.2-0.3-1.2-0.6-4.1 1.3-2.2 0-0.1 0.4-0.6 0.5-0.4 0.7-0.2 0.8 0 0.7 0.4 0.3 1.6 0.5 0.4 0.8 0.3 0.5 0.6 0.3 0.9 0.5 0.6 0.7 0.3 1 0.4 0.6 0.5 0-0.6 1-0.2 0.5-0.3 0.6-0.2 1.4-0.2 0.2-0.1 0.5-0.4 0.3-0.5 0.3-0.4 0.2-0.1 0.5 0 0.5 0.3 0.7 0.5 1 1 0.3 0.6 0 0.4 0 0.3 0 0.4 0.1 0.2 1.6 1.6 0.3 0.2 0.4 0.1 0.6-0.1 1-0.3 0.3-0.1 0.6 0.2 0.5 0.3 1.6 1.9 0.3 0.5 0.2 0.6-0.1 0.7-0.1 0.3-0.3 0.5-0.4 0.4-0.5 0.2-2.8 0.7-0.5 0.3-0.1 0.2-0.1 0.3 0.1 0.3 0.3 0.4 2.3 1.9 0.3 0.4 0.1 0.5 0 0.4-0.1 0.6-0.1 0.4 0.1 0.3 0.2 0.2 0.4 0.2 0.8-0.1 0.4-0.1 0.3-0.3 0.2-0.5 0-1 0-0.4 0.2-0.3 0.2-0.2 0.3 0 0.3 0.2 0.3 0.4 0.2 0.3 0.3 0.6 0.3 0.3 0.4 0.1 1.8 0.1 0.3 0.1 0.3 0.1 0.2 0.4 0 0.4 0.1 0.6 0.4 0.4 2.3 1.4 0.5 0.6 0.3 0.5-0.1 0.7 0.1 0.3 0.1 0.3 0.4 0.3 0.6 0.2 2.8 0.2 0.8 0 0.5-0.2 0.5-0.3 0.5-0.2 0.6-0.2 1.2-0.3 3.5 0.6 0.7-0.1 0.4-0.3-0.1-1 0-0.3 0.2-0.2 0.5 0.1 0.7 0.4 1.6 1 1.1 0.6 1.7-0.3 0.6 0 0.2 0.2 0.3 0.2 0.3 0.3 0.3 0.6 0.1 0.9-0.2 2.5z"
-        id="12" name="L'viv" fill="{{ if (index .alerts 12) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="12" name="L'viv" fill="{{ if (index .alerts "12") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M228 197.3l-1.2 0.6-0.3 0.3 0.2 0.2 0.3 0.1 1.9 0.6 0.5 0.3 0.4 0.4 0.3 0.5 0.1 0.3 0 0.4-0.2 0.7-0.2 0.4-0.3 0.2-0.6 0.1-2.1 0.2-0.2 0.1-0.1 0.3-0.1 0.3 0.2 0.3 0.4 0.5 0.7 0.6 0.2 0.2 0 0.4-0.2 1-0.3 0.6-0.2 0.2-1 0.6-0.2 0.1-0.1 0.3 0.1 0.3 0.3 0.4 0.3 0.2 0.4 0.2 0.7 0 0.3 0.2 0.1 0.4-0.1 0.7-0.1 0.4-0.2 0.3-3.2 1-0.4 0.4-0.4 0.4-0.3 0.4 0.1 0.7 0.5 1.4 0.2 0.9 0.1 0.7-0.2 0.6-0.4 1 0 0.4 0.1 0.4 0.3 0.6 0.3 0.3 0.7 0.6 0.4 0.4 0.3 0.5 0 0.5 0 0.5-0.1 1-0.2 1-0.1 0.4 0 0.4 0.3 0.7 0.4 0.7 1.3 1 0.3 0.5 0.2 0.5 0.1 0.3 0.1 0.6 0 0.8-0.1 1.7-0.1 0.7-0.2 0.5-0.6 0.6-0.4 0.5-0.2 0.5-0.1 0.3 0 0.4 0.1 0.5 0.3 0.6 0.4 0.6 1.5 1.3 0.4 0.4 0 0.4 0 0.5-0.2 0.7-0.2 0.4-0.2 0.3-0.6 0.6-0.4 0.4-0.2 0.6-0.1 0.7-0.2 2.2-0.2 1-0.2 0.3-0.3 0.5-1.5 0.8-0.4 0.3-0.2 0.4-0.8 1.9-0.1 0.5 0 0.4 0.1 0.5 0.3 1 1 2.1 0.5 1.5 0.5 2.3 0.2 0.5 0.2 0.3 1.4 1 0.2 0.2 0.1 0.3 0 0.5 0 0.9 0.1 0.8 0.4 1.6 0 0.5 0 0.4-0.2 1-0.1 0.5 0.1 0.4 0.2 0.6 0.4 0.7 0.6 0.9 0.1 0.3 0 0.4-0.2 0.6-0.3 0.7-0.1 0.4 0 0.5 0 0.9-0.1 0.4-0.2 0.3-0.8 0.3-0.5 0.2-0.4 0.4-0.2 0.3-0.1 0.3-0.1 0.4 0 0.7 0 0.8-0.3 1.3 0 0.5 0 0.4 0.3 0.7 0.2 0.3 0.6 0.7 0.1 0.3 0.1 0.3-0.1 0.4-0.4 0.9 0 0.4-0.1 0.7 0 0.4 0.1 0.5 0.9 1.9 0.1 0.6 0 0.4-0.2 0.6-0.1 0.4-0.3 0.3-0.5 0.5-0.4 0.5-0.3 0.5 0 0.4 0.1 0.6 0.4 0.9 0.6 1 0.1 0.5 0 0.1-0.3 0.6-0.7 0.2-0.2 0.2 0 0.3 0.3 0.7 0.1 0.5-0.1 0.4-0.2 0.2-0.5 0.3-0.2 0.2 0.1 0.4 0.4 0.7 0.2 0.3 0.1 0.5 0 0.7-0.1 0.5-0.4 0.9 0 0.3 0 0.3 0.1 0.2 0.4 0 0.8-0.2 0.2 0.2 0.1 0.3 0 0.4-0.2 1.1-0.2 2.7 0 0.4 0.1 0.4 0.8 1.2 0.4 0.4 1.1 0.4 0.3 0.2 0 0.2-0.1 0.3-0.3 0.2-0.6 0.1-0.2 0.1-0.1 0.2-0.1 0.6-0.4 0.7-0.2 0.3 0.1 0.3 0.1 0.4 0.7 0.8 0.3 0.4 0.1 0.3-0.1 0.5-0.2 0.6-0.1 0.6 0 1.7-0.1 0.7-0.3 0.9-0.1 0.7 0.1 0.4 0.3 0.5 0.7 0.6 0.4 0.2 0.5 0.1 0.3 0.1 0.3 0.1 0.1 0.2-0.1 0.8 0 0.3 0.2 0.4 0.5 0.3 0.3 0.1 0.2-0.2 0.4-0.4 0.1-0.1 0.2 0.1 0 0.9-0.1 0.5-0.4 0.8-0.1 0.3 0 0.3 0.1 0.4 0.4 0.4 0.4 0.2 1.1 0.7 0.3 0.2 0.5 0.5 0.7 0.4 0.2 0.2-0.2 0.4-0.2 0.1-0.5 0.2-0.1 0.3 0.2 0.7 0.4 1.1 0.9 2-1.5 0.5-1.5-0.1-1.8-0.7-1.4-0.8-0.7-0.3-1-0.1-3.2 0.7-1.7-1.1-0.3-2.4 0.1-2.4-0.7-1-1.6-0.2-0.1 0.4 0 1.2 0.2 0.9 0.4 0.9 0.9 1.3-0.7 1.1-1 0.1-1.1-0.6-0.9-0.8-0.6-1.2 0-1 0.5-2.7 0-1-0.2-1.2-0.5-0.5-0.9 0.4-0.2 0.5-0.2 1.3-0.3 0.5-0.5 0-1.4-0.6-0.7 0.6-0.7 1.6-0.9 0.7-0.9 0.1-0.9-0.1-2.7-1 0-0.3 0-0.7-0.2-0.5-1.1-1.5-1-0.8-0.7-1.3-0.8-0.7-1.5 1-0.4 0.7-0.1 0.8-0.3 0.6-0.6 0.2-0.6-0.4-0.4-2-0.5-0.5-2.4-0.2-1.2-0.4-0.7-0.5 0-0.5 0.3-0.5 0.2-0.6-0.3-1-0.6-0.7-0.1-0.1 0-0.2 0.1-0.7 0.2-0.6 0.3-0.2 0.1-0.5-0.6-1.3-0.4-0.3-1.5-0.9-1.7-1.6-0.9-0.5-1.4-0.1-1-0.4-1.3-0.7-1.1-1-0.7-0.9 0.5-0.5 0.2-0.5 0.5-1.2-0.5-0.1-0.8-0.4-0.4-0.1-0.5 0.2 0 0.5 0.1 0.6-0.1 0.4-0.9 0.2-0.8-0.2-0.6-0.4-0.7-0.2-1.2 0.1-0.2 0.5 0.1 0.7 0.1 1.1-0.1 1.2-0.1 0-0.5-0.2-0.8 0.1-2.8 2.8-1.5 0.5-0.6-2.1 0.6-1.4 3.1-0.9 1.2-1.2-3-1.4-1.3-0.1-1.4 1.5-0.2-1.5 0.3-2.3 0.5-2.1 0.5-1 0-0.6-0.8-0.1-0.6 0.2-1.2 1-0.5 0.6-0.3 0.6-0.2 0.7-0.1 1-0.3 1-0.4 0.5-0.7-0.6-1.3-2.1-0.4-0.8 0-0.9 0.4-1.4 0-0.8-0.5-0.6-3-1.8-0.8-0.1-2.3 0.4-1 0-0.8-0.7-0.8-1.3-0.2-0.7-0.2-1.1-0.3-0.6-0.5-0.3-1.8-0.8-0.6-0.5-0.2-0.5 0-0.6 0.1-0.3 0.1-0.2 0.3-0.2 0.6 0 0.7 0.1 1.6 0.9 0.6 0.1 0.3 0.1 0.5-0.2 0.2-0.2 0.4-0.4 0-0.3 0-0.6-0.3-0.5-0.1-0.6 0-0.7-0.1-0.5-0.1-0.4-0.3-0.6-0.2-0.3-0.3-0.3-1.7-0.3-1.1-0.5-0.2 0-0.6 0.2-0.9 0.7-0.3 0.1-0.5 0-0.3-0.2-0.3-0.5-0.2-0.6 0.1-0.7 0.1-0.7 0-0.7-0.1-0.4-0.4-1.1 0-0.2 0.1-0.6 0.3-0.5 0.8-0.7 0.2-0.2 0.2-0.6 0.1-0.7 0-0.7-0.2-0.9-0.2-0.8-0.2-0.6-0.3-0.4-1.1-0.4-0.3-0.3-0.1-0.3-0.1-0.3-0.1-1-0.2-1.6-0.2-0.6-0.1-0.3-0.1-0.6 0-0.3 0.1-0.7 0.3-0.5 0.1-0.3 0-0.4-0.4-0.8-0.2-0.6-1-2.8-0.9-1.7-4.5-7 0.7-1.6 0.2-1.1 0.3-2.7 0.3-0.5 0.3-0.2 0.7 0 0.3 0.1 0.5 0 0.5-0.2 9.6-5.4 0.3-0.3 1.5-2.9 0.4-0.9 0.3-0.9 1.2-2.4 0.3-0.3 0.3-0.2 0.3 0 0.5 0.6 0.3 0.1 0.6 0.1 0.5-0.1 0.4-0.1 1.2-0.7 0.3-0.4 0.3-0.3 0.1-0.7 0-0.3-0.1-0.6-0.2-0.3-0.9-1.4-0.4-0.8 0-0.3 0-0.7 0.3-1.5 0.2-0.6 0.3-0.3 0.3-0.1 2.6-0.3 0.6-0.2 0.5-0.2 4.1-0.8 0.3-0.1 2-1.1 1.2-0.3 0.3-0.2 1.1-0.7 0.3-0.1 0.8-0.1 0.6-0.1 0.9-0.5 0.5-0.4 0.3-0.3 0.3-0.5 0.1-0.3-0.1-0.6-0.1-0.6-0.3-0.5 0-0.4 0-0.4 0.3-0.7 0.3-0.3 0.3-0.2 0.8-0.1 0.5-0.1 0.3-0.3 0.1-0.3-0.1-0.6-0.3-0.5-0.6-0.9-0.2-0.3-0.2-0.5-0.3-0.5-1.2-0.8-0.2-0.2-0.3-0.4-0.2-0.3-0.1-0.5 0-0.6 0.1-1.3 0.1-0.4 0.2-0.3 0.3-0.1 0.8 0.2 0.3 0.2 0.4 0.4 0.2 0 0.2-0.8 0-0.8 0-0.3-0.1-1 0.2-0.7 0.2-0.2 1.6-1 0.3-0.4 0.2-0.4 0.1-0.3 0.4-0.8 0-0.3-0.1-0.2-0.2-0.2-0.6-0.6-0.4-0.4-0.1-0.6 0.1-0.7 0.3-1.4 0.2-0.5 0.5-0.2 0.6 0.1 1.4 0.4 1.2 0.5 1.4 0.4 0.9 0.6 0.3 0.1 0.5-0.3 0.3-0.2 1.2-1.2 0.2-0.1 0.3 0 0.3 0.2 0.4 0.6 0.3 0.1 0.3 0 0.6-0.3 0.3-0.3 0.8-0.8 0.5-0.3 1-0.2 6.4-0.6 0.8-0.4 0.4-0.3 5.1-5.6 1.4-1.1 0.5 0.1 0.7 0.1 1.6 0.6 0.8 0.2 0.5 0 1.5-0.8 0.6-0.2 0.6 0 0.7 0.2 3.5 1.7 0.4 0.1 0.4 0 0.3 0 1.6-0.7 0.4-0.3 0.6-0.7 0.2-0.1 0.3 0.1 0.3 0.4 0.1 0.3 0.1 0.4-0.2 0.6-0.2 0.3-0.6 0.7-0.2 0.6-0.3 0.6-0.1 0.6 0 0.5 0.1 0.4 0.5 0.4 0.4 0.3 0.4 0.4 0.1 0.4 0.2 2.6z"
-        id="18" name="Ternopil'" fill="{{ if (index .alerts 18) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="18" name="Ternopil'" fill="{{ if (index .alerts "18") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M41.5 294.6l1 0.6 1 1.2 0.1 0.7-0.5 1.1-0.2 0.6 0 1.1 0 0.3 0.1 0.9 0.2 0.6 0.3 0.4 1.4 1.7 2 1.6 0.3 0.5 0.2 0.4 0.2 0.6 0.4 0.7 0.4 0.2 0.4 0.1 0.9 0 0.4 0 0.4-0.1 0.5-0.3 2.3-0.7 1.5-0.3 0.4 0.1 0.4 0.3 0.6 0.5 0.4 0.7 1.3 3.3 1.1 2 0.3 0.4 0.4 0.3 0.5 0.4 0.5 0.1 2.6 0.3 0.7-0.1 0.6-0.1 0.5-0.6 0.2 0 0.2 0 0.2 0.7 0 1 0.1 0.5 0.3 0.4 0.3 0 0.4-0.2 0.3-0.2 0.8-1.1 0.2-0.2 0.3-0.1 0.4 0.1 0.5 0.5 0.2 0.4 0 0.4 0 0.5 0.1 0.3 0.2 0.5 0.1 0.2 0.5 0.3 0.6 0.2 1.6 0.3 1.1 0.1 2.9-0.4 0.6 0.1 1.3 0.6 2.3 0.6 2.1 1 3.5 4.2 1 0.8 0.4 0.1 0.5 0 0.8-0.3 0.6-0.1 0.7 0 0.7 0.1 0.6 0.2 0.3 0.2 0.2 0.3 0.2 0.7 0 0.5-0.2 1.1 0.1 0.6 0.2 0.4 0.3 0.4 3.1 2.6 0.5 0.3 0.5 0.2 0.7 0.1 0.5 0.2 0.4 0.3 0.5 0.6 0.2 0.4 0.4 0.9 0.1 0.5 0.1 0.5-0.2 0.7-0.2 0.6-0.1 0.6-0.1 0.7 0.1 0.3 0.2 0.3 0.3 0.2 0.6 0.3 0.4 0.1 0.4 0 0.5-0.2 0.5-0.3 0.2-0.2 0.9-1 1.2-0.8 2.4-0.4 1.1-0.5 2.7-1.8 0.5-0.1 0.3 0 0.3 0.2 0.2 0.3 0 0.6 0 0.4-0.1 0.8-0.1 0.5 0.1 0.9 0.2 0.6 0.1 0.6 0 0.3-0.1 0.7-0.1 0.3-0.4 2.1 0 0.7 0.1 1 0.4 2.5 0.1 0.3 0.2 0.4 0.3 0.5 0.7 0.7 0.4 0.3 0.4 0.2 1.7 0.4 1.4 0 1.3-0.2 0.5-0.2 0.7-0.5 0.3-0.4 0.5-0.8 0.3-0.8 0.2-0.2 0.2-0.1 0.4 0.3 0.4 0.3 0.6 0.5 0.3 0.4 0.2 0.5 0.2 1 0.1 1 0.2 0.3 0.2 0 1.4 0 0.4 0.2 0.3 0.3 0.5 0.6 0.6 0.6 1.1 0.8 3.6 3.4 0.4 0.5 1 2.1 1.1 1.8 0.1 0.3 0.1 0.4-0.2 0.5-0.3 0.7-0.3 0.5-0.3 0.5-0.2 0.6 0 0.4 0 0.4 0.1 0.5 0.3 0.8 0.2 0.4 0.4 0.5 3.8 3.8 1.8 2.7 0.1 0.3-0.1 0.4-2.9 4.3-0.3 1-0.5 2.9-0.2 1-0.4-0.3-1.1-0.3-3.1 0.1-3.2-0.8-1.1 0.1-1.2 0.6-2.2 1.9-2.7 0.1-3.7 1.9-1.2 0-3.3-1.3-0.1 0.1-1-0.2-2-2-1.1-0.5-2.7-0.7-0.9-0.7-1.8-0.1-5.6 2.3-1.1 0-0.4-1.2-2.9-2.7-0.9-0.5-3.9 0.2-1.2-0.2-2.3-0.7-3.6-0.4-1-0.4-2.1 0.4-0.6 0.2-0.6 0.5-0.2 0.5-0.3 0.5-0.3 0.4-0.4 0.3-1.2 0.1-3.6-1.8-0.2-0.1-0.5 0.2-0.4 0.1-0.5-0.1-0.4-0.1-1.2-1.5-2.6-2.3-2.3-2.7-1-0.7-3.8-1.4-1.3-0.1-1.1 0.5-1.1 1.7-1.3 3.9-0.7 1.4-2.4 1.8-0.8 0.2-0.9-0.3-0.9-0.5-0.9-0.6-1-0.5-0.8 0.1-0.5 1 0.5 1.6-0.5 1.2-0.9 0.6-1.2 0.4-2-1.7-0.5-1 1-1.1 0.1-1.3 0.3-0.8 0.2-0.9-0.3-1.5-0.6-1.2-0.7-0.9-1.7-1.6-2-1.1-0.2-0.4-0.9-0.6-0.9 0.3-1 0.6-1 0.4-4 0-0.7 0.4-0.1-0.1-0.3-0.3-1-2-0.8-2.6-0.7-1.7-4.1-5.4-0.5-0.2-0.2 0-1.1 0.6-0.8 0-0.9-0.1-1.1-0.5-0.4 0-0.3 0.1-0.4 0.4-0.4 0.1-0.4-0.1-0.4-0.4-2.7-4.2-0.6-1.7 0-1.1 0.1-1 0-0.8-0.4-0.7-0.4-0.1-1.5 0.1 0-1.3 0.8-2.5-2-1-1.9-0.3-1.8 0.7-0.5 0.7-0.2 0-1.3-0.3 0.1-6 0.6-1.3 0.2-1.3-0.7-3.4 0.1-1.7 0.9-1.4 3.6-2.9 0.3-0.6 0.6-1.4 0.4-0.6 0.7-0.4 1.5-0.5 0.6-0.4 0.9-1.2 0.7-1.6 0.4-1.8-0.1-1.8 0.2-1.1 0.4-0.5 0.5-0.5 0.5-0.7 0.4-0.9 0.1-0.6-0.1-2.8-0.1-0.7 0-0.7 0.4-1 0.5-0.8 1.3-1.1 0.5-0.7 0.2-0.6-0.1-1 0.1-0.5 0.7-1.5 1.2-3.5 1-0.8 2.1-0.3 0.9-0.7-0.1-1.4 0.3-2 0.4-1.9 0.4-1.4 1.2-1.1 1.1 0.3 2.1 2.3 1.3 0.9 1.2 0.2 1.2-0.1 2 0.1 1.3-0.4 0.6 0.1 0.5 0.5 1 1.5 0.7 0.2 0.9 0.5 1.3 1.1 1.1 0.5 0.6-1.4-0.1-0.2z"
-        id="6" name="Transcarpathia" fill="{{ if (index .alerts 6) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="6" name="Transcarpathia" fill="{{ if (index .alerts "6") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M190 41.6l0.3 1.6 0.7 0.4 5 0.3 0.3 0.1 0.1 0.2-0.2 0.2-0.8 0.5-0.4 0.3-0.2 0.3-0.1 0.3-0.1 0.5 0 0.6 0.1 0.6 0.1 0.5 0.4 0.9 0.1 0.6 0 0.4-0.1 0.4-0.1 0.5-0.6 1.1-0.7 1.1-0.4 0.4-2 1.7-2.7 3.9-0.4 0.9-0.2 0.6-0.1 0.9-0.3 1.8 0 1.5 0.1 1.8 0 1.1 0.1 0.7 0.3 0.5 0.5 0.3 0.7 0.1 0.4 0 0.6-0.1 0.2-0.1 0.2 0.2 0.2 1 0.1 0.2 0.3 0.2 1 0.3 0.4 0.3 0.3 0.6 0 0.4-0.1 0.6-0.5 0.9-0.3 0.4-1 0.9-0.4 0.4-0.3 0.5-0.1 0.4-0.1 0.5-0.1 1.4 0 0.4 0.2 0.8 0.6 2.4 0.1 0.7 0 0.3-0.1 0.8-0.2 1.5-0.1 1.2 0 0.7 0.1 1.1 0 0.3-0.4 3.3 0.1 0.7 0.1 0.3 0.3 0.5 0.4 0.5 0.2 0.1 0.5 0.1 0.2-0.1 0.2-0.2 0.2-0.3 0.3-0.9 0.3-0.6 0.2-0.2 0.7-0.5 0.6-0.2 0.6-0.1 0.7-0.1 0.7 0.1 1.2 0.5 0.3 0 0.3 0 0.3-0.1 0.2-0.2 0.4-0.4 0.2-0.3 0.4-0.7 0.2-0.3 0.2-0.2 0.2-0.1 0.3 0 0.2 0.2 0.2 0.5 0.3 0.5 0.6 0.3 0.3 0.1 0.7 0 0.4-0.1 0.5-0.2 0.8-0.8 0.2-0.1 0.1 0.2 0 0.8-0.5 2-0.2 0.9-0.1 0.7 0.1 0.3 0.3 0.5 0.3 0.5 0.6 0.3 1 0.5 0.3 0.3 0.2 0.5 0.1 0.7 0 0.3 0.1 0.3 0.4 0.4 1.6 1.3 1.1 1.3 0.3 0.5 0.4 0.5 4.2 2.1 2.8 2.8 0.2 0.2 0.2 0.6 0 0.4-0.1 1 0 0.5-0.3 0.6-0.5 0.9-0.6 0.6-0.5 0.4-3.9 0.8-0.2 0.2-0.1 0.5-0.3 2.4 0 0.5 0.2 0.2 0.3 0.2 1.4 0.6 0.3 0 0.2-0.1 0.6-0.5 0.1-0.1 0.6-0.1 0.6-0.1 0.5 0.3 0.5 0.4 0.9 1 0.9 1.6 0.2 0.5 0.1 0.7 0 0.3 0.5 0.7 0.2 0.6 0.1 1.1 0.1 0.6-0.1 0.4-0.2 0.4-0.8 0.6-0.4 0.2-0.5 0.2-3.9 0.5-0.3 0.3-0.3 0.3-0.2 0.6 0 0.4 0.2 0.3 0.2 0.2 0.9 0.7 0.2 0.3 0.2 0.3 0.3 0.3 0.1 0.2 0.1 0.3 0 0.7-0.2 0.7-0.2 0.3-1.4 1.3-0.7 1.1-0.2 0.6 0.1 0.4 0.2 0.1 1.3 0.7 0.3 0.2 0.2 0.4 0 0.2-0.4 0.6-0.2 0.2-0.3 0.1-0.5 0.1-1-0.3-0.7 0-0.3 0.1-0.4 0.3-2.5 2-0.2 0.1-0.3 0.2-0.3 0.3-0.3 0.7-0.1 0.3-0.2 4 0.3 1.7 0 0.7 0 0.7-0.1 0.6-0.4 0.7-0.4 0.4-0.3 0.7-0.2 0.3-0.5 2.9-0.2 0.3-0.3 0.1-0.5 0.1-0.4-0.1-0.4-0.1-0.4-0.4-1.2-1.2-0.4-0.4-0.6-0.3-2.2-0.3-0.2-0.2-0.2-0.2 0.1-0.5 0-0.3-0.2-0.3-1.1-1.3-0.8-0.8-0.3-0.1-0.7-0.2-2.9 0-1.1 0.6-1.2 0.9-2.1 1.2-1.9 0.7-1.7 1-0.5 0.2-0.5 0-1.4-0.3-1.1-0.5-0.7-0.4-0.9-0.8-0.1-0.1-0.3 0.1 0 0.4 0 0.3 0.3 0.9 0 0.4-0.2 0.4-0.4 0.6-0.1 0.6 0.1 0.4 0.2 0.2 1.2 0.8 0.2 0.2 0.1 0.3-0.1 0.2-0.5 0.2-0.4 0.1-3.9-0.6-0.3 0.2-0.2 0.2-0.1 0.6 0.1 0.4 0.7 1.3 0.3 0.8 0 0.4 0 0.7 0 0.3-0.1 0.5-0.3 0.4-0.6 0.5-0.4 0.2-0.5 0.1-4.2-0.4-2.6-0.7-0.2 0-0.3 0.1-0.3 0.2-0.6 0.8-0.1 0.3-0.1 0.4 0.3 0.4 0.2 0.3 1.6 1.2 0.6 0.6 0.1 0.2-0.1 0.2-0.9 1.7-0.4 0.6-0.4 0.4-0.2 0.1-0.3 0.1-0.3 0-1.1-0.1-0.3 0-0.2 0.1-0.1 0.3 0.1 0.4 0.2 0.2 0.2 0.3 1 0.6 0.8 0.8 0.5 0.7 1.4 2.5 0.2 0.2 0.3 0.1 1 0.2 0.3 0.1 0.1 0.3 0.1 0.3 0 0.4-0.1 0.4-0.4 0.5-0.7 0.4-0.5 0.1-0.5 0.2-0.5 0.3-0.6 1-1.2 1.4-3.3 3.1 0.2-2.5-0.1-0.9-0.3-0.6-0.3-0.3-0.3-0.2-0.2-0.2-0.6 0-1.7 0.3-1.1-0.6-1.6-1-0.7-0.4-0.5-0.1-0.2 0.2 0 0.3 0.1 1-0.4 0.3-0.7 0.1-3.5-0.6-1.2 0.3-0.6 0.2-0.5 0.2-0.5 0.3-0.5 0.2-0.8 0-2.8-0.2-0.6-0.2-0.4-0.3-0.1-0.3-0.1-0.3 0.1-0.7-0.3-0.5-0.5-0.6-2.3-1.4-0.4-0.4-0.1-0.6 0-0.4-0.2-0.4-0.3-0.1-0.3-0.1-1.8-0.1-0.4-0.1-0.3-0.3-0.3-0.6-0.2-0.3-0.3-0.4-0.3-0.2-0.3 0-0.2 0.2-0.2 0.3 0 0.4 0 1-0.2 0.5-0.3 0.3-0.4 0.1-0.8 0.1-0.4-0.2-0.2-0.2-0.1-0.3 0.1-0.4 0.1-0.6 0-0.4-0.1-0.5-0.3-0.4-2.3-1.9-0.3-0.4-0.1-0.3 0.1-0.3 0.1-0.2 0.5-0.3 2.8-0.7 0.5-0.2 0.4-0.4 0.3-0.5 0.1-0.3 0.1-0.7-0.2-0.6-0.3-0.5-1.6-1.9-0.5-0.3-0.6-0.2-0.3 0.1-1 0.3-0.6 0.1-0.4-0.1-0.3-0.2-1.6-1.6-0.1-0.2 0-0.4 0-0.3 0-0.4-0.3-0.6-1-1-0.7-0.5-0.5-0.3-0.5 0-0.2 0.1-0.3 0.4-0.3 0.5-0.5 0.4-0.2 0.1-1.4 0.2-0.6 0.2-0.5 0.3-1 0.2 0 0.6-0.6-0.5-1-0.4-0.7-0.3-0.5-0.6-0.3-0.9-0.5-0.6-0.8-0.3-0.5-0.4-0.3-1.6-0.7-0.4-0.8 0-0.7 0.2-0.5 0.4-0.4 0.6-1.4-3.4-0.5-1.8 0.4-2-1.5-0.4-1.5-0.9-0.8-1.4 0.7-1.5 0-0.5-1.5-0.2-1.4-0.6-0.8-1.1-0.1-1.7 0.7-1.5 1.3-1 1.5-0.2 1.5 0.8 1.1-0.5 1.8 0 1.7-0.3 0.7-1.6-0.7-1.1-4.6-2.5-3.8-3.5-0.8-1.4-0.3-1.1-0.1-0.8-0.1-0.7-0.6-1-0.7-0.4-0.7-0.3-0.4-0.4 0.2-0.7 0-0.5-0.6-3.7-0.5-1.2-1.5-2.3-0.8-1.7 0.2-0.8 0.9-0.5-0.6-1.1-2.6-2.7-2.8-1.7-1.3-1.6-3-6.7-1-0.6-1.1 0-0.8-0.5-0.4-1.9 0.3-1.2 0.8-1.3 1-1 1-0.4-0.4-1.2 0.1-0.9 0.4-0.6 0.6-0.3-0.4-1.1-2.3-3.3-0.1-0.6 0.9-1.8-0.8-0.5-1-0.4-0.9-0.6-0.3-1.2-0.1-0.6 0.5 0.4 0.5-0.2 0.2-1.4-0.1-0.9-1.5-4.3-0.3-0.7 0-0.7 0.7-1.2 0.5-0.6 0.7-0.3 5.4-1.4 1.3 0 3.9 1.2 1.4 0.1 2.2 0.9 1.5 1.9 1.6 1.5 2.2-0.4 8.3-7.5 6.3-4.3 1.5-2.2 1.4-5.8 0.8-1.8 2-3 1.2-1.2 1.2-0.5 13.8-1.1 3.4 0.9 1.1 0 15.6-2.5 5-2.7 2.5-0.8 2.5 0 9.3 2.5 10.8 0.2z"
-        id="2" name="Volyn" fill="{{ if (index .alerts 2) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="2" name="Volyn" fill="{{ if (index .alerts "2") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M551.1 189.8l0.6 0.1 0.5 0 0.3 0 0.3 0.3 0.3 0.6 0.3 0.9 0.8 1 0.2 0.2 0.2 0.3 0.1 0.4 0.2 0.6 0.2 0.5 0.4 0.4 0.3 0.2 1.2 0.2 0.6 0.3 0.3 0.2 1 1.5 0.3 0.2 0.3 0 0.5-0.3 0.5-0.3 0.3-0.4 0.5-0.3 0.3 0 0.2 0.1 0.4 0.4 0.1 0.3 0.1 0.4 0.1 1-0.2 0.5-0.2 0.3-1.9 0.6-0.2 0.2 0.1 0.5 1.1 3.9 0.2 0.4 0.4 0.3 0.4 0.2 1.3 0.6 0.3 0 0.3 0 0.8-0.3 0.3 0 0.3 0.1 2.5 5 0.4 0.6 0.2 0.2 1.3 0.8 0.2 0.3 0.2 0.4 0.1 0.8-0.1 0.5 0 0.5-0.5 1.8 0 0.8 0.1 1.5-0.1 0.5-0.2 0.3-0.4 0.2-0.2 0-0.5-0.2-0.3-0.1-0.3 0.1-0.2 0.2-0.2 0.2-0.1 0.3 0.2 0.7 0.3 0.8 2 3.6 1 1.3 0.5 0.3 0.2 0 0.3 0 0.5-0.3 0.3-0.1 0.2 0 0.3 0.1 0.2 0.2 0.8 0.8 0.4 0.6 0.2 0.4 0.3 0.3 0.5-0.1 0.5-0.2 0.2-0.1 0.3 0.1 1.8 1.5 0.2 0.3 0.1 0.5 0.1 0.9 0 0.5-0.1 2.3 0.2 0.7 0.4 0.3 0.3 0.3 6 2.3 0.3 0.4 0.2 0.5 0.1 2.5 0.1 0.4 0.3 0.6 0.2 0.4 0.3 0.2 1.4 1.1 0.4 0.6 0.4 1.5 0 0.4 0 0.6-0.1 0.9-0.2 0.5-0.2 0.4-0.1 0.2-1.2 0.7-0.5 0.4-0.1 0.2-2.2 3.7 0 0.4 0.1 0.5 0.5 1 0.6 0.8 0.3 0.4 2.2 2.9 0.3 0.5 2.3 6.1 0.1 0.7 0 1.4-0.2 4-0.6 2.4-0.1 0.3-1.3 3.6 0 0.3 0 0.3 0.4 0.4 0.6 0.5 0.4 0.5 0.3 0.4 0.4 1.1 0.4 2 0.1 0.9 0 2.3 0 0.6 0.1 0.3 1.3 3.7 0.2 0.2 0.2 0.2 1 0.3 0.2 0.1 0.1 0.2 0 0.7-0.2 0.4-0.4 0.4-2.5 2.1-0.4 0.4-0.2 0.4-0.3 0.3-0.4 0.1-0.9 0-0.8-0.3-0.7-0.6-1.4-1-0.6-0.2-0.4-0.1-0.3 0.1-0.4 0.4-0.2 0.3-0.3 0.7-0.3 0.5-0.5 0.7-1 0.8-0.3 0.2-0.5 0-0.7 0-0.4-0.1-0.3-0.2-0.7-0.5-0.5-0.3-0.3-0.1-0.3 0.1-0.4 0.4-0.2 0.7 0.1 0.3-0.1 0.7-0.2 0.2-0.4 0.2-0.8 0-0.4-0.1-0.2-0.2-0.1-0.6-0.1-1.6-0.2-0.8-0.4-0.7-2.1-2.9-0.4-0.8-0.1-0.2 0-0.3 0.1-2.1-0.1-0.3-0.1-0.3-0.2-0.1-0.3 0.1-0.5 0.4-0.7 0.7-0.3 0.1-0.4 0.1-0.7 0-0.9-0.2-0.6-0.2-0.2-0.2-0.2-0.2-0.1-0.6-0.1-0.3-0.2-0.2-0.5-0.3-0.1-0.2-0.1-0.3 0.1-0.6 0-0.3-0.1-0.3-0.3-0.1-0.3-0.1-0.2 0.1-0.8 0.7-0.6 0.2-0.7 0.7-0.2 0.3-0.1 0.2 0.2 0.9 0 0.3-0.2 0.1-0.3 0.1-0.8-0.2-0.6-0.2-0.3-0.1-0.4 0.1-0.4 0.4-0.2 0.2-0.9 1.7-0.5 0.8-0.7 1.3-0.4 0.4-2 1.3-0.3 0.3-0.3 0.4-0.2 0.6-0.1 0.3 0.1 0.2 0.5 0.6 0.2 0.3-0.1 0.3-0.1 0.7-0.4 1.4-0.1 0.3-0.3 0.3-0.4 0.2-1 0-0.4-0.1-0.3-0.2-0.2-0.5-0.4 0-1.6 0.3-0.3-0.1-0.2-0.2-0.1-0.4-0.5-0.3-0.3 0-0.3 0.1-1 1-0.3 0.2-2.4 0.8-0.8 0.5-0.4 0.4-0.3 0.6-0.4 0.5-0.7 0.6-0.3 0.5-0.3 0.3-0.5 0.2-0.7-0.1-0.3-0.4-0.2-0.4-0.3-1.4-0.3-0.9-0.2-0.4-0.5-0.6-1.7-1.2-4.4-1.6-0.4-0.1-1.9 0-0.3 0.1-0.4 0.3-0.4 0.6-0.2 0.6-0.3 0.4-0.5 0.3-3.3-0.1-1.7-0.6-0.5-0.1-0.5 0.2-0.5 0.6-0.2 0.5-0.1 0.6 0 1.1 0.2 1.6 0 1.6-0.1 0.5-0.2 1-0.2 0.4-0.4 0.4-1.4 1.2-0.6 0.6-0.1 0.4-0.2 0.5 0 0.5-0.3 0.9-0.4 1.3-0.4 0.4-0.7 0.5-1.8 0.5-1.1 0.5-1.3 0.4-0.7 0-0.4 0.1-0.5 0.6-0.3 0.1-0.2-0.1-0.2-0.2-0.4-0.8-0.3-0.3-0.2-0.2-0.8-0.3-0.7-0.1-1 0.1-0.3 0-1.2-0.5-0.7-0.1-2 0.1-0.4 0.2-2.7 1.6-0.9 0.3-2.2 0-0.5 0-0.7 0-0.2-0.2-0.6-0.5-1.1-0.6-1.6-0.5-1.4-0.1-0.9-0.4-0.6-0.1-2.8 0.2-0.6-0.2-0.5 0-2 0.5-0.7 0.1-0.4-0.1-0.2-0.2-0.3-0.5-0.2-0.1-0.5-0.1-0.7 0.1-0.4 0-0.4-0.2-0.5-0.2-0.4-0.1-0.8 0.1-0.7-0.2-0.4 0-0.6 0.2-1.8 1-1.7 1.6-1.3 1-0.5 0.6-0.1 0.4 0.3 0.5 0 0.3 0.1 0.5 0 0.2-0.6 2.4-0.1 0.3-0.4 0.5-2.1 1.8-0.5 0.7-0.2 0.4 0.4 0.4 1.1 0.5 0.3 0.2 0.1 0.2 0.1 0.2 0 1.7 0 0.7-0.2 0.6-0.3 0.3-0.6 0.3-4 0.4-0.3 0-0.4-0.4-0.1-0.2-0.3-0.5-0.2-0.2-0.3-0.1-1-0.2-1.3 0.1-0.3 0.1-0.3 0.3-0.4 0.5-0.2 0.4-0.1 0.5-0.1 1-0.2 0.6-7.2 3.8-0.5 0.7-0.2 0-0.4 0-0.8-0.2-0.4-0.2-0.3-0.2-0.3-0.5-0.2-0.2-0.3-0.1-0.5 0.1-0.6 0.3-1.6 1.2-1.4 1.5-0.7 0.3-1.7-0.2-0.9-0.8-0.5-0.5-0.5-0.2-0.6 0-0.6 0.2-2.6 1.1-0.5 0.1-0.2 0-0.2-0.2-0.1-0.3 0.1-0.6 0.1-0.3 0.1-0.2 1-0.9 0.2-0.2 0.3-0.5 0.2-0.4 0.1-0.5 0.1-0.3 0.4 0 0.2-0.1 0.3-0.4 0.3-0.4 0.2-0.4 0-0.7 0-0.4-0.3-1.1-0.8-1.7-1-1.3-0.6-0.9-0.5-1-0.1-0.4-0.1-0.7 0-2.4-0.1-0.3-0.1-0.3-0.4-0.2-2.4-1-1.8-1.3-0.4-0.4-0.4-0.4-0.1-0.4 0-0.4 0.1-0.8 0.1-0.4 0.3-0.3 0.9-0.5 0.2-0.2 0.1-0.2 0.1-0.3-0.3-0.3-0.2-0.2-0.3-0.1-0.4-0.3-0.3-0.3-0.5-0.7-0.4-0.2-0.3-0.1-1.5 0.1-2-0.3-0.3-0.1-0.2-0.7-1-5.2-0.3-0.7-0.2-0.2-1.4-0.3-0.4-0.4-0.3-0.7-0.1-0.7 0-0.4 0.2-0.4 0.2-0.2 0.6-0.5 0.2-0.3 0-0.4-0.2-0.7-0.3-0.7-0.4-0.4-0.5-0.3-0.3-0.1-0.9 0-0.2-0.1-0.8-0.7-1.1-0.6-0.1-0.2 0-0.3 0.3-0.5 0.2-0.4 0.4-1.2 0.3-0.3 0.3-0.2 0.3 0 0.2-0.2 0.1-0.4-0.1-0.9-0.3-0.6-0.4-0.4-0.6-0.2-1.7 0-0.3-0.1-0.5-0.3-0.4-0.5-0.2-0.7 0-0.6 0.1-0.5 0.2-0.5 0.6-1 0.5-0.3 1.9-1.2 1-0.4 0.2-0.3 0.1-0.4-0.1-1.4 0-0.4 0.4-0.4 0.2-0.2 1.1-0.6 0.1-0.2 0.1-0.4-0.2-0.9-0.1-0.5-0.1-0.4 0-0.4 0.2-0.4 0.4-0.6 0-0.4-0.1-0.4-0.3-0.5-0.3-0.3-0.3-0.2-0.8-0.5-0.3-0.1 0-0.7 1.3-1.4 2.1 0.6 0.5 0.5-0.1 0.3-0.4 0.2-0.3 0.2-0.1 0.3 0.1 0.6 0.2 0.4 0.4 0.5 1.1 0.8 0.9 0.4 3.3-0.4 1.4 0.1 0.4-0.2 0.2-0.3-0.2-0.9 0.1-0.3 0-0.3 0.3-0.4 0.8-0.8 0.4-0.4 0.5-0.8 0.1-0.4 0.3-0.9 0.3-0.5 0.7-0.3 1.2-0.9 0.5-0.2 1.9 0 0.5-0.1 0.4-0.2 0.6-0.9 0.4-0.5 0.4-0.2 0.4 0.1 0.4 0.1 1.6 0.1 0.3 0.1 0.6 0.3 0.2 0.1 0.5 0.1 0.3-0.1 0-0.3-0.2-0.5-0.3-0.7-0.2-0.4 0-0.2 0.2-0.3 0.4-0.4 0.8-0.4 0.4-0.5 0.6-0.7 0.3-0.1 0.3 0 1.2 0.5 0.2 0.2 0 0.2-0.1 0.3-0.6 1-0.3 0.5-0.1 0.4 0 0.3 0.1 0.2 0.2 0.2 0.3 0.2 9.7 2 0.4 0.3 0.1 0.2 0 0.7 0 0.3 0.3 0.4 0.5 0.3 0.5 0 0.3-0.2 0.1-0.3 1.1-4.6 0.3-1 0.1-0.3 0-1 0-0.7 0.2-0.7 0.2-0.3 0.2-0.4 0.6-0.3 0.4-0.2 0.4 0 0.3 0.1 0.5 0.3 0.6 0.6 0.5 0.8 0.4 0.4 0.4 0 1.8-0.6 0.3-0.2 0.6-0.6 0.3 0 0.2 0.1 1.6 0.9 0.3-0.1 0.2-0.1 0.3-0.5 0.7-1.5 0.2-0.3 0.3 0 0.2 0.1 0.4 0.4 0.4 0.1 0.7 0.1 0.8-0.3 0.5 0 0.3 0 0.4 0.4 1.1 1.9 0.2 0.3 0.3 0.2 0.3 0 0.3 0 1-0.7 1.8-0.5 0.2-0.2 0.6-0.7 0.3-0.3 0.5-0.3 0.3 0.1 0.9 0.4 0.6-0.1 0.3-0.1 0.6-0.6 0.5-0.4 0.4 0 0.2 0.1 0.3 0.5 0.2 0.3 0.4 0.2 0.7 0.2 0.4-0.4 0.2-0.4 0-0.3 0-1 0-0.3 0.2-0.7 1.3-2.4 0.6-0.7 1-0.8 0.2-0.2 0.5-0.8 0.6-0.6 1.4-1.3 0.3-0.5 0.3-0.3 2.4-1.6 0.3-0.5 0.3-0.4 0.9-2.3 0.3-0.4 0.5-0.5 1.7-1.2 0.3-0.3 0.1-0.6 0.1-1 0-0.6-0.1-0.3-0.2-0.2-0.2-0.2-0.7-0.2-0.2-0.1-0.2-0.2-0.1-0.6 0-1 0.1-0.3 0.3-0.4 0.6-0.6 0.2-0.3 0.7-1.9 0.6-1.1 0.1-0.7 0-0.3-0.3-0.8 0-0.2 0-0.3 0.1-0.3 0.8-1.3 0.1-0.3 0.5-3.1 0-0.3-0.1-0.3-0.1-0.2-0.5-0.4-0.1-0.2 0-0.7 0.2-0.4 0.6-1.3 0.1-0.6-0.1-0.2-0.7-0.5-0.2-0.2-0.1-0.3 0-0.2 0.2-0.3 0.7-0.9 0.5-1.1 0.4-0.5 0.4-0.3 0.3-0.2 1.9-0.2 4.3-3 0.6-0.2 0.6-0.1 2 0 0.6-0.1 1-0.3 0.4-0.1 0.6 0.1 0.2 0.1 0.2 0.2 0.1 0.9 0.1 0.3 0.3 0.5 0.6 0.6 0.2 0.5 0 0.7 0.3 0.5 0.4 0.5 0.4 0.6 0.3 0.2 0.3-0.2 0.1-0.3 0.1-0.8 0.2-0.4 0.3-0.5 0.3-0.1 0.2 0 0.5 0.4 0.3 0.2 0.4 0.1 0.7-0.1 0.4-0.1 0.3-0.2 0.3-0.5 0.1-0.3 0.3-1.6 0.2-0.4 0.2-0.2 0.3 0 0.3 0.1 0.2 0.3 0.1 0.5-0.1 1.8 0.1 0.3 0.1 0.2 0.3 0.2 5.2 1 0.5-0.2 0.2-0.4 0.6-1.2 0.6-1 1-1 0.1-0.2 0.2-0.7-0.1-0.9 0.2-0.5 1.4-1.9 0.5-1.2 0.3-0.6 0.1-0.7 0-0.7 0-0.7-0.2-1.2 0.1-0.2 0.2-0.4 0.2-0.2 0.3-0.1 2.1-0.7 0.5-0.2 0.3-0.3 0.1-0.7-0.2-0.5-0.3-1.1-0.3-1.2 0-0.7-0.1-0.6-0.4-1.1-0.1-0.3 0.3-0.4 0.4-0.5 1.8-1.4 0.3-0.3 0.3-0.6 0.3-0.8 0.2-0.5 0.3-0.1 0.5 0.3 0.4 0 0.7-0.1 0.7-0.3 1.1-1.2 0.4-0.5 0.3-0.6 0.1-0.3 0-0.6-0.2-0.5-0.8-1.1-0.2-0.4 0-0.6 0.1-0.7 0.2-0.4 0.3-0.5 0.6-0.7 0.2-0.3 0.3-0.2 0.5-0.2 0.3 0 0.7 0 0.4 0.2 0.5 0.3 0.5 0.3 0.7 0.3z"
-        id="22" name="Cherkasy" fill="{{ if (index .alerts 22) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="22" name="Cherkasy" fill="{{ if (index .alerts "22") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M653.5 307l-1.4 2.2-0.3 1-0.1 0.4-0.1 0.3-0.2 0.4-0.5 0.4-0.4 0.7-0.3 0.4-0.5 0.3-0.4 0.7-0.4 1.4-0.3 0.5-0.3 0.2-0.3 0.1-0.5-0.3 0-0.8-0.3-0.6 0-0.2-0.1-1-0.1-0.2-0.2 0-0.2 0.3-0.4 0.6-0.3 0.4-0.4 0.1-0.1-0.2-0.1-0.3 0.2-1.1 0-0.3-0.2-0.2-0.2-0.2-0.7-0.1-0.3 0-0.5 0.4-1 1.4-0.4 0.2-0.3 0.1-0.3 0-0.3-0.2-0.2-0.2-0.1-0.2-0.2-0.9-0.2-0.2-0.2-0.2-0.6-0.2-0.4 0-0.3 0.1-2.2 1.9-0.3 0-0.4 0-0.2-0.2-0.3-0.5-0.2-0.2-0.2 0-0.3 0-0.3 0.2-0.4 0.4-0.8 1.3-0.1 0.5 0.3 0.9 0.3 0.4 0.2 0.2 0.5 0.3 0.2 0.2 0.2 0.5 0.3 0.8 0.4 0.4 0.2 0.1 3.1 0.6 0.3 0.1 1.2 0.9 0.6 0.1 3.3 0.2 0.2 0.2 0.3 0.5 0.1 0.6 0.1 0.2 0.3 0.1 2.2 0.5 0.3 0.2 0.1 0.2-0.1 0.3-0.4 0.4-0.7 0.6-0.5 0.2-0.4 0.1-0.6 0.1-0.3 0.2-2 2.1-0.5 0.3-5.8 2.9-0.5 0.3-1.5 1.6-0.4 0.2-0.3 0-0.1-0.4-0.2-0.1-4.7 1.9-1.6 1-0.2 0.3-0.2 0.3 0 0.7 0.1 0.7 0.1 0.4 0.2 0.1 0.5 0 0.2 0.1 0 0.2-0.1 0.6 0 0.4 0.2 0.5 1.2 2.8 0 0.3-0.1 0.3-0.4 0.3-0.2 0.3-0.1 0.5 0.1 0.7 0.1 0.3 0.2 0.1 0.5 0.3 0.1 0.5 0 0.7 0.4 1.7 0.1 4.3 0.1 0.7 0.2 0.4 0.2 0.2 0.7 0.2 0.2 0.2 0.1 0.2 0.1 0.6-0.6 3.9-0.1 0.4-0.3 0.3-0.7 0.2-0.4 0.1-0.4 0-0.3 0.1-0.3 0.2-0.6 1.2-0.2 0.2-2.2 2.1-0.5 0.6-0.3 0.3-0.3 0.1-0.7 0.1-0.6 0-0.4-0.4-0.5-0.6-0.2-0.1-0.3 0-0.2 0.2-0.2 0.5-0.3 0.5-2.3 2.5-0.1 0.3-0.5 1.2-0.4 1.3-0.2 0.2-0.2 0.2-0.3-0.2-0.2-0.2-0.1-0.3-0.2-0.8 0-0.6 0.1-0.3 0.3-0.7 0-0.2-0.4-2.3-0.2-0.4-0.2 0-0.3 0.1-0.2 0.2-0.3 1.5-0.2 1-0.2 0.7 0.1 0.6 0 0.6 0.1 0.6-0.1 0.7-0.3 1-0.2 0.3-0.4 0.2-1.3 0.4-2.1-0.2-0.4 0.1-0.4 0.3-0.8 0.6-0.5 0.5-0.4 0.5-0.5 1-0.4 0.6-0.6 0.4-0.5 0.1-0.4 0.3-0.2 0.2-0.6 2.2-0.6 2.8-0.9-0.7-0.2-0.2 0-0.3 0-1-0.1-0.5-0.2-0.4-1-1.2-0.2-0.4-0.2-0.6-0.1-0.5-0.2-0.6-0.2-0.1-0.3 0-1.2 0.8-0.3 0.1-1.4-0.3-0.6 0-0.7 0.2-0.4 0.2-0.2 0.3-0.1 0.3-0.1 0.6 0.1 0.6 0.2 0.8 0 0.4-0.2 0.2-0.4 0.3-1.7 0.3-0.2 0.2-0.1 0.3-0.4 0.9-0.1 0.2-0.3 0.1-0.4 0-0.6-0.3-0.3-0.3-0.2-0.2-0.6-0.9-0.2-0.2-0.3 0-1.1 0.4-2.3 0.4-0.7 0.4-0.4 0.3-0.2 0.2-0.3 0.5-0.2 0.6-0.1 0.7 0.1 0.6 0.3 0.4 0.3 0.1 0.9 0.2 0.2 0.1 0.1 0.2 0 0.7-0.1 0.6-0.2 0.2-0.2 0-1.5 0.2-0.6 0.2-0.2 0.1-0.2 0.5-0.1 0.3 0.2 0.5 0.4 0.7 0.1 0.6-0.1 0.7 0 0.3 0.1 0.3 0.5 0.6 0 0.2-0.1 0.2-0.2 0.2-1.1 0.2-0.2 0.1-0.1 0.3-0.1 0.7 0 0.3 0 1.3-0.1 0.2-0.1 0.3-0.3 0-9.6 1.6-0.5-0.1-3.7-1.3-0.2-0.2-0.7-0.1-0.6 0.1-0.6 0.3-0.3 0.1-0.2-0.1-1-1-1.5-0.9-0.6-0.2-0.9-0.2-2.1 0.3-0.4 0.2-0.3 0.2-0.3 0.4-1.2 2-0.7 1.5-0.7 0.9-0.3 0.4-0.3 0.1-0.5 0.2-0.6 0.1-0.4-0.1-0.6-0.2-1.1-0.6-0.5-0.3-0.2-0.4-0.2-0.5-0.5-0.9-0.4-0.5-0.2-0.1-1.3-0.7-0.6-0.6-0.4-0.2-2-0.3-0.6 0.1-0.4 0.2-0.2 0.9-0.2 0.2-0.3 0.1-4.8 0-0.4 0-0.3-0.2-0.2-0.4-0.1-0.4 0-0.4 0.1-0.7 0-0.6-0.6-0.9-0.1-0.6 0-0.3 0.3-0.5 0.9-1.5 0.3-0.5 0-0.3-0.1-0.6-0.2-0.5-1.2-1.5-0.2-0.2-0.2-0.5-0.1-0.9-0.2-0.5-0.3-0.4-0.3-0.1-0.3-0.1-1.4 0.3-0.3-0.1-0.7-0.6-0.7-0.9-1.7-3-0.8-1.4-0.1-0.5 0.2-0.1 2.2-1 0.6-0.5 0.4-0.4 0.1-0.3 0.1-0.7 0-0.6-0.3-0.8-0.4-1.4-0.1-0.2-0.3-0.2-0.5-0.1-2.2 0.5-0.3-0.1-0.4-0.1-0.5-0.4-0.4-0.1-0.4-0.1-0.7 0.1-0.5-0.2-0.3-0.2-0.8-0.9-0.2-0.1-0.4-0.1-0.9 0.4-0.3 0.2-0.2 0.2-0.1 0.3 0 0.3 0 0.9 0 0.3-0.5 0.3-0.8 0.1-0.3 0-0.3-0.1-0.2-0.3-0.2-0.2-0.3 0-0.4 0.3-0.2 0.3-0.1 0.4-0.1 1-0.1 0.6-0.2 0.2-0.2 0.1-0.4 0-0.3-0.1-0.3-0.3 0-0.5 0.3-0.7 0.2-0.6 0.2-1.8-0.1-0.6-0.2-0.4-0.4-0.3-3.1 0.3-0.7 0.2-0.5 0.2-0.2 0.2-0.2 0.1-0.4-0.1-0.4-0.3-0.3-0.2-0.2-0.6-0.2-0.2-0.2-0.1-0.9 0.4-0.9 0.7-0.5 0-5.1-0.7-0.6-0.3-0.3-0.2-0.2-0.4-0.1-0.3 0-0.3 0-1.3-0.1-0.4-0.2-0.2-1.3-0.3-0.4-0.3-0.2-0.2 0-0.3-0.2-2.2-0.1-0.6-0.4-0.1-0.5-0.2-0.9-0.1-0.9-0.3-4-0.3-1.2 0.1-1.9 0.7-0.7 0.4-0.5 0.5-0.1 0.7-0.2 0.6-0.2 0.2-0.4 0.2-1.8 0.6-0.4 0.3-0.3 0.4-0.2 0.2-0.4 0.3-0.4 0.1-0.4-0.1-0.8-0.3-0.2-0.3-0.2-0.3 0-0.6-0.1-0.3-0.4-0.4-0.3-0.1-0.3-0.1-0.4 0.1-0.5 0.4-0.2 0.2-0.2 0.3-0.4 1.1-0.2 0.2-0.3 0-0.6-0.2-0.2-0.3-0.2-0.3-0.1-0.6-0.5-0.2-0.7-0.1-1.7 0-1.6-0.3-0.6 0.1-2.6 0.9-0.7 0.4-0.5 0.3-0.1 0.2-0.2 0.7-0.3 0.4-0.3 0.2-0.6 0-2.1-0.5-2.5-0.9-0.4-0.1-0.5 0-0.5-0.1-8.5 0-0.3 0.3-0.4 1.5-0.3 0.5-0.4 0.1-1.6-0.1-11.6-0.6-2-1-1.1-1.6-0.8-2-0.9-1.8-0.9 0.3-0.7 0.5-0.5 0.1-0.3 0-0.3-0.3-0.7-0.7-0.4-0.1-0.3 0.1-0.1 0.2-0.4 0.5-1.2 2.3-0.3 0.3-0.3 0.2-0.3 0-0.2-0.1-0.3-0.2-1-1.3-1-0.8-0.3-0.1-0.3-0.1-0.2 0-0.9 0.4-1.4 0.2-0.1-0.6 0.3-0.7 0.3-0.4 0.1-0.4 0.1-0.3-0.3-0.7 0.2-0.8-0.1-0.3-0.5-0.2-1.4-0.4-0.5-0.3-0.1-0.2-0.1-0.2 0.3-0.6 1-1.5 0.4-0.6 0.4-1.2 0.1-0.4 0-0.7 0.1-0.7-0.1-0.8 0.1-0.4 0.5-0.7 1.8-1.7 1.2-1.5 0.3-0.5 0.6-1 0.4-0.4 1-0.9 0.3-0.1 0.5 0 0.8 0.2 0.2 0.3 0.1 0.3-0.1 0.7 0.1 0.3 0.4 0.7 0.1 0.3 0.3 1.4 0.3 0.5 0.1 0.2 0.4-0.2 0.6-0.5 1.2-1.5 0.8-1.4 0.2-0.3 0.4-0.2 1.9-0.3 0.7-0.4 1.4-1.9 1.7 0.2 0.7-0.3 1.4-1.5 1.6-1.2 0.6-0.3 0.5-0.1 0.3 0.1 0.2 0.2 0.3 0.5 0.3 0.2 0.4 0.2 0.8 0.2 0.4 0 0.2 0 0.5-0.7 7.2-3.8 0.2-0.6 0.1-1 0.1-0.5 0.2-0.4 0.4-0.5 0.3-0.3 0.3-0.1 1.3-0.1 1 0.2 0.3 0.1 0.2 0.2 0.3 0.5 0.1 0.2 0.4 0.4 0.3 0 4-0.4 0.6-0.3 0.3-0.3 0.2-0.6 0-0.7 0-1.7-0.1-0.2-0.1-0.2-0.3-0.2-1.1-0.5-0.4-0.4 0.2-0.4 0.5-0.7 2.1-1.8 0.4-0.5 0.1-0.3 0.6-2.4 0-0.2-0.1-0.5 0-0.3-0.3-0.5 0.1-0.4 0.5-0.6 1.3-1 1.7-1.6 1.8-1 0.6-0.2 0.4 0 0.7 0.2 0.8-0.1 0.4 0.1 0.5 0.2 0.4 0.2 0.4 0 0.7-0.1 0.5 0.1 0.2 0.1 0.3 0.5 0.2 0.2 0.4 0.1 0.7-0.1 2-0.5 0.5 0 0.6 0.2 2.8-0.2 0.6 0.1 0.9 0.4 1.4 0.1 1.6 0.5 1.1 0.6 0.6 0.5 0.2 0.2 0.7 0 0.5 0 2.2 0 0.9-0.3 2.7-1.6 0.4-0.2 2-0.1 0.7 0.1 1.2 0.5 0.3 0 1-0.1 0.7 0.1 0.8 0.3 0.2 0.2 0.3 0.3 0.4 0.8 0.2 0.2 0.2 0.1 0.3-0.1 0.5-0.6 0.4-0.1 0.7 0 1.3-0.4 1.1-0.5 1.8-0.5 0.7-0.5 0.4-0.4 0.4-1.3 0.3-0.9 0-0.5 0.2-0.5 0.1-0.4 0.6-0.6 1.4-1.2 0.4-0.4 0.2-0.4 0.2-1 0.1-0.5 0-1.6-0.2-1.6 0-1.1 0.1-0.6 0.2-0.5 0.5-0.6 0.5-0.2 0.5 0.1 1.7 0.6 3.3 0.1 0.5-0.3 0.3-0.4 0.2-0.6 0.4-0.6 0.4-0.3 0.3-0.1 1.9 0 0.4 0.1 4.4 1.6 1.7 1.2 0.5 0.6 0.2 0.4 0.3 0.9 0.3 1.4 0.2 0.4 0.3 0.4 0.7 0.1 0.5-0.2 0.3-0.3 0.3-0.5 0.7-0.6 0.4-0.5 0.3-0.6 0.4-0.4 0.8-0.5 2.4-0.8 0.3-0.2 1-1 0.3-0.1 0.3 0 0.5 0.3 0.1 0.4 0.2 0.2 0.3 0.1 1.6-0.3 0.4 0 0.2 0.5 0.3 0.2 0.4 0.1 1 0 0.4-0.2 0.3-0.3 0.1-0.3 0.4-1.4 0.1-0.7 0.1-0.3-0.2-0.3-0.5-0.6-0.1-0.2 0.1-0.3 0.2-0.6 0.3-0.4 0.3-0.3 2-1.3 0.4-0.4 0.7-1.3 0.5-0.8 0.9-1.7 0.2-0.2 0.4-0.4 0.4-0.1 0.3 0.1 0.6 0.2 0.8 0.2 0.3-0.1 0.2-0.1 0-0.3-0.2-0.9 0.1-0.2 0.2-0.3 0.7-0.7 0.6-0.2 0.8-0.7 0.2-0.1 0.3 0.1 0.3 0.1 0.1 0.3 0 0.3-0.1 0.6 0.1 0.3 0.1 0.2 0.5 0.3 0.2 0.2 0.1 0.3 0.1 0.6 0.2 0.2 0.2 0.2 0.6 0.2 0.9 0.2 0.7 0 0.4-0.1 0.3-0.1 0.7-0.7 0.5-0.4 0.3-0.1 0.2 0.1 0.1 0.3 0.1 0.3-0.1 2.1 0 0.3 0.1 0.2 0.4 0.8 2.1 2.9 0.4 0.7 0.2 0.8 0.1 1.6 0.1 0.6 0.2 0.2 0.4 0.1 0.8 0 0.4-0.2 0.2-0.2 0.1-0.7-0.1-0.3 0.2-0.7 0.4-0.4 0.3-0.1 0.3 0.1 0.5 0.3 0.7 0.5 0.3 0.2 0.4 0.1 0.7 0 0.5 0 0.3-0.2 1-0.8 0.5-0.7 0.3-0.5 0.3-0.7 0.2-0.3 0.4-0.4 0.3-0.1 0.4 0.1 0.6 0.2 1.4 1 0.7 0.6 0.8 0.3 0.9 0 0.4-0.1 0.3-0.3 0.2-0.4 0.4-0.4 2.5-2.1 0.4-0.4 0.2-0.4 0-0.7-0.1-0.2-0.2-0.1-1-0.3-0.2-0.2-0.2-0.2-1.3-3.7-0.1-0.3 0-0.6 0-2.3-0.1-0.9-0.4-2-0.4-1.1-0.3-0.4-0.4-0.5-0.6-0.5-0.4-0.4 0-0.3 0-0.3 1.3-3.6 0.1-0.3 0.6-2.4 13.8 6.8 5.8 4.4 0.4 0.4 0.4 0.4 1 1.6 0.4 1 0.6 1 0.2 0.3 0.4 0.3 5.2 0.5 0.2 0.1 0.2 0.8 0.3 0.3 0.4 0.2 1.3 0.4 0.3 0.3 0.1 0.3-0.1 0.3-0.2 0.2-0.3 0.1-0.8 0.2-0.2 0.1 0 0.3 0 0.9 0 0.3-0.2 0.2-0.3 0.4-0.5 0.3-0.1 0.2 0.3 0.3 0.8 0.7 0.2 0.2 1 1.3 0.2 0.5 0.2 0.2 1 0.7 0.3 0.2 0.2 0.4 0.2 0.2 0.2 0 0.5-0.3 0.5-0.9 0.6 0 0.8 0.2 1.7 0.8 0.6 0.5 0.4 0.4 0.4 0.3 2.4 1 0.5 0.5 0.3 0.3 0.2 0.3 0.3 0.1 0.6-0.2 0.4-0.2 0.2-0.2 0.6-1.1 0.4-0.1 0.5 0 2 1 0.4 0.4 0.6 0.6 0.2 0.2 0.4 1 0.2 0.6 0 0.5 0.2 0.2 0.2 0 0.4-0.3 0.2-0.3 0.1-0.4 0.2-1.5 0.3-0.6 0.3-0.2 0.6-0.1 1-0.1 0.5-0.2 0.4-0.2 0.1-0.3 1.7-0.3 1.6 1.6 1 0.3 1.9 0.1 0.9 0.1 0.9 0.5 0.4 0.5 1.1 1.7z"
-        id="10" name="Kirovohrad" fill="{{ if (index .alerts 10) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="10" name="Kirovohrad" fill="{{ if (index .alerts "10") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M467.3 101.9l0.1 0.7-0.1 0.3-0.1 0.2-0.2 0.2-0.3 0-1.1-0.1-0.3 0.1-0.2 0.2-0.1 0.4 0.1 0.5 0.7 1.7 0.1 0.6 0.1 0.7-0.1 0.7-1 4.9-0.1 0.8-0.1 5.4 0.2 2.6 0.1 0.4 0.1 0.2 0.3 0.2 0.6 0 0.3-0.2 0.5-0.4 0.2-0.2 0.3 0 0.7 0.4 0.6 0.5 0.7 0.2 0.5 0 0.3 0 0.5 0.1 0.5 0.3 1.4 1.3 0.3 0.4 0.2 0.5 0.2 0.9 0.1 0.7 0 0.7-0.3 1.5-0.3 1.5-0.1 0.4 0 0.7 0.1 0.6 0.1 0.3 0.4 0.3 0.5 0.3 3 1.1 0.5 0.3 1.8 1.4 0.5 0.5 0.2 0.4 0 0.2-0.3 1.9-0.1 0.7-0.4 1-0.5 1.2-0.8 1.4-0.2 0.5 0 0.4 0.2 0.2 0.3 0.2 0.6 0 1.6-0.5 0.4 0.1 0.5 0.2 0.6 0.6 0.3 0.4 0.4 0.5 0.2 0.2 0.3 0.1 0.5-0.1 0.3-0.2 0.3-0.2 0.4-0.9 0.2-0.1 0.3-0.1 2.6 0.7 0.7 0.1 0.4-0.1 0.8-0.8 0.6-0.2 0.7 0 3 0.2 0.6 0 0.6-0.2 0.3-0.1 0.6-0.5 0.5-0.3 0.3 0 0.4 0.1 0.2 0.3 0.2 0.5 0.5 0.7 0.4 0.5 0.1 0.3-0.1 0.3-0.2 0.7 0 0.3 0.2 0.3 0.3 0.2 0.8 0.1 0.8 0.1 0.4 0.1 0.2 0.2 0 0.4 0 0.8 0.2 0.3 1.3 1.1 0.7 0.8 0.4 0.5 0.2 0.5 0.2 0.5 0.3 1.2 0.1 0.9 0 0.7-0.1 0.4-0.2 0.6-0.4 0.5-0.2 0.2-0.2 0.1-0.6-0.1-0.2 0.1-0.2 0.3-0.1 0.7-0.1 0.3 0.1 0.3 0.3 0.5 1.6 1.6 0.3 0.2 0.3 0.2 1.1-0.1 0.4 0.1 0.3 0.2 0.2 0.4 0.1 0.4-0.1 1.2 0.2 0.5 0.3 0.5 0.2 0.2 0.3 0.1 0.4 0.1 0.6 0 0.5 0.2 0.5 0.4 0.3 0.1 0.4 0.2 0.6-0.2 0.3-0.1 0.3-0.2 0.8 0 1.1 0.1 4.3 1.1 0.6-0.5 0.6-0.7 0.4-0.3 0.5-0.1 3.4 0.8 0.3 0 0.5-0.2 0.5-0.4 0.2-0.1 0.3-0.1 0.8 0.1 0.3 0 0.2-0.2 0.2-0.2 0.6-0.7 0.4-0.3 0.3-0.1 0.6-0.1 0.3-0.1 0.2-0.2 0.3-0.5 0.4-0.4 0.5-0.3 0.6-0.1 2.2 0.2 0.4 0 0.2-0.1 0.4-0.5 0.7-1 0.2-0.2 0.5-0.2 0.2-0.2 0-0.2 0-0.6 0-0.3 0.1-0.3 0.3-0.4 0.1-0.3 0.1-0.9 0.1-0.3 0.5-0.3 1.1-0.4 1.2-0.1 0.5 0.2 0.6 0.4 1.6 1.6 0.7 0.5 2 2.7 0.5 0.6 0.5 0.4 0.3 0.1 0.7 0.1 2.3 0 0.3 0.3 0.3 0.5 0.1 1.2-0.1 1.2-0.1 0.6-0.2 0.2-0.3 0.2-1.2-0.2-0.3 0.1-0.2 0.1-0.6 0.9-0.3 0.5-0.1 0.3 0 0.4 0.2 0.5 1.3 1.1 0.2 0.2 0.2 0.3 0.1 0.6 0 0.8 0.1 0.6 0.2 0.5 0.3 0.3 0.4 0.3 0.8 0.4 0.5 0.1 0.4 0 0.7-0.1 0.6 0.1 0.3 0.2 0.1 0.2 0 0.4-0.1 0.3-0.8 0.8-0.3 0.4 0.3 0.5 0.3 0.4 3.4 2-1.4 3.8-0.9 1.3-0.2 0.3-0.1 0.2 0.1 0.4 0.3 0.5 0.1 0.3-0.3 0.7-1.5 2.2-0.7-0.3-0.5-0.3-0.5-0.3-0.4-0.2-0.7 0-0.3 0-0.5 0.2-0.3 0.2-0.2 0.3-0.6 0.7-0.3 0.5-0.2 0.4-0.1 0.7 0 0.6 0.2 0.4 0.8 1.1 0.2 0.5 0 0.6-0.1 0.3-0.3 0.6-0.4 0.5-1.1 1.2-0.7 0.3-0.7 0.1-0.4 0-0.5-0.3-0.3 0.1-0.2 0.5-0.3 0.8-0.3 0.6-0.3 0.3-1.8 1.4-0.4 0.5-0.3 0.4 0.1 0.3 0.4 1.1 0.1 0.6 0 0.7 0.3 1.2 0.3 1.1 0.2 0.5-0.1 0.7-0.3 0.3-0.5 0.2-2.1 0.7-0.3 0.1-0.2 0.2-0.2 0.4-0.1 0.2 0.2 1.2 0 0.7 0 0.7-0.1 0.7-0.3 0.6-0.5 1.2-1.4 1.9-0.2 0.5 0.1 0.9-0.2 0.7-0.1 0.2-1 1-0.6 1-0.6 1.2-0.2 0.4-0.5 0.2-5.2-1-0.3-0.2-0.1-0.2-0.1-0.3 0.1-1.8-0.1-0.5-0.2-0.3-0.3-0.1-0.3 0-0.2 0.2-0.2 0.4-0.3 1.6-0.1 0.3-0.3 0.5-0.3 0.2-0.4 0.1-0.7 0.1-0.4-0.1-0.3-0.2-0.5-0.4-0.2 0-0.3 0.1-0.3 0.5-0.2 0.4-0.1 0.8-0.1 0.3-0.3 0.2-0.3-0.2-0.4-0.6-0.4-0.5-0.3-0.5 0-0.7-0.2-0.5-0.6-0.6-0.3-0.5-0.1-0.3-0.1-0.9-0.2-0.2-0.2-0.1-0.6-0.1-0.4 0.1-1 0.3-0.6 0.1-2 0-0.6 0.1-0.6 0.2-4.3 3-1.9 0.2-0.3 0.2-0.4 0.3-0.4 0.5-0.5 1.1-0.7 0.9-0.2 0.3 0 0.2 0.1 0.3 0.2 0.2 0.7 0.5 0.1 0.2-0.1 0.6-0.6 1.3-0.2 0.4 0 0.7 0.1 0.2 0.5 0.4 0.1 0.2 0.1 0.3 0 0.3-0.5 3.1-0.1 0.3-0.8 1.3-0.1 0.3 0 0.3 0 0.2 0.3 0.8 0 0.3-0.1 0.7-0.6 1.1-0.7 1.9-0.2 0.3-0.6 0.6-0.3 0.4-0.1 0.3 0 1 0.1 0.6 0.2 0.2 0.2 0.1 0.7 0.2 0.2 0.2 0.2 0.2 0.1 0.3 0 0.6-0.1 1-0.1 0.6-0.3 0.3-1.7 1.2-0.5 0.5-0.3 0.4-0.9 2.3-0.3 0.4-0.3 0.5-2.4 1.6-0.3 0.3-0.3 0.5-1.4 1.3-0.6 0.6-0.5 0.8-0.2 0.2-1 0.8-0.6 0.7-1.3 2.4-0.2 0.7 0 0.3 0 1 0 0.3-0.2 0.4-0.4 0.4-0.7-0.2-0.4-0.2-0.2-0.3-0.3-0.5-0.2-0.1-0.4 0-0.5 0.4-0.6 0.6-0.3 0.1-0.6 0.1-0.9-0.4-0.3-0.1-0.5 0.3-0.3 0.3-0.6 0.7-0.2 0.2-1.8 0.5-1 0.7-0.3 0-0.3 0-0.3-0.2-0.2-0.3-1.1-1.9-0.4-0.4-0.3 0-0.5 0-0.8 0.3-0.7-0.1-0.4-0.1-0.4-0.4-0.2-0.1-0.3 0-0.2 0.3-0.7 1.5-0.3 0.5-0.2 0.1-0.3 0.1-1.6-0.9-0.2-0.1-0.3 0-0.6 0.6-0.3 0.2-1.8 0.6-0.4 0-0.4-0.4-0.5-0.8-0.6-0.6-0.5-0.3-0.3-0.1-0.4 0-0.4 0.2-0.6 0.3-0.2 0.4-0.2 0.3-0.2 0.7 0 0.7 0 1-0.1 0.3-0.3 1-1.1 4.6-0.1 0.3-0.3 0.2-0.5 0-0.5-0.3-0.3-0.4 0-0.3 0-0.7-0.1-0.2-0.4-0.3-9.7-2-0.3-0.2-0.2-0.2-0.1-0.2 0-0.3 0.1-0.4 0.3-0.5 0.6-1 0.1-0.3 0-0.2-0.2-0.2-1.2-0.5-0.3 0-0.3 0.1-0.6 0.7-0.4 0.5-0.8 0.4-0.4 0.4-0.2 0.3 0 0.2 0.2 0.4 0.3 0.7 0.2 0.5 0 0.3-0.3 0.1-0.5-0.1-0.2-0.1-0.6-0.3-0.3-0.1-1.6-0.1-0.4-0.1-0.4-0.1-0.4 0.2-0.4 0.5-0.6 0.9-0.4 0.2-0.5 0.1-1.9 0-0.5 0.2-1.2 0.9-0.7 0.3-0.3 0.5-0.3 0.9-0.1 0.4-0.5 0.8-0.4 0.4-0.8 0.8-0.3 0.4 0 0.3-0.1 0.3 0.2 0.9-0.2 0.3-0.4 0.2-1.4-0.1-3.3 0.4-0.9-0.4-1.1-0.8-0.4-0.5-0.2-0.4-0.1-0.6 0.1-0.3 0.3-0.2 0.4-0.2 0.1-0.3-0.5-0.5-2.1-0.6-0.5-1.2-0.6-0.3-0.9 0.1-0.7 0-0.4-0.2-0.2-0.2-0.1-0.4-0.4-0.8-1-1.5-0.7-1.7-0.3-0.3-0.2-0.3-0.2-0.1-1.6-0.2-0.6-0.2-0.8-0.4-0.3-0.4-0.2-0.3 0-0.7-0.2-0.5-0.2-0.6-0.8-1.6-0.1-0.8 0.1-0.6 0.2-0.4 0.4-0.4 2.7-1.8 0.2-0.2 0.3-0.6 0-0.7 0-1.1-0.2-0.7-1.2-2.6-0.2-0.5 0-0.3 0.1-0.3 0.3-0.3 0.4-0.2 0.4-0.3 0.2-0.4 0.1-1 0-0.6-0.1-0.4-0.2-0.3-2.2-1.1-0.3-0.2-0.4-0.4-0.4-0.6-0.2-0.5-0.1-0.4-0.1-0.9 0-0.7 0.1-0.7 0.1-0.3 0.6-1.1 0.2-0.8 0.1-0.6-0.1-0.3-0.1-0.3-0.5-0.3-0.3-0.2-2.8-0.4 0.2-1.3 0.5-0.4 0.8-0.4 0.2-0.4 0.2-0.4 0.1-0.4 0-0.7 0-0.5-0.1-0.3-0.1-0.2-0.6 0-1.3 0.2-0.7 0-0.7-0.1 0-0.4 0.4-0.5 0.3-0.5 0.4-0.8 0.3-1 0.2-1.1 0.1-0.6-0.1-0.5-0.3-0.6-0.1-0.4-0.2-0.7 0.1-0.4 0.1-0.3 0.5-0.3 0.2-0.1 0.3 0 0.7 0.1 0.4-0.2 0.3-0.3 0.3-0.6 0.3-0.2 0.3-0.1 0.5 0.1 0.5-0.1 2-1.5 1.8-0.8 0.4-0.3 0.6-0.7 0.3-0.1 0.8-0.3 0.3-0.2 0.4-0.4 0.9-1.2 0.4-0.9 0.3-0.4 0.2-0.2 1.5-0.8 0.4-0.3 1.3-1.4 0.3-0.5 0.1-0.5-0.1-0.6-3.2-5.2-0.2-0.7 0-0.6 0.2-0.3 0.3-0.4 0.2-0.5-0.8-3.2-0.1-0.8 0-0.5 0.1-0.4 0.2-0.3 0.3-0.1 1.8-0.5 0.4-0.2 0.4-0.4 0.4-0.9 0-0.4-0.1-0.4-0.3-0.5 0-1.1 0-0.6-0.2-0.4-0.2-0.2-0.8-0.5-0.4-0.3-0.1-0.3-0.2-0.5-0.1-0.3 0.1-1.9-0.1-1 0.3-3.4 0-0.8-0.2-0.4-0.7-0.3-0.2-0.1-0.4-0.4-0.5-0.9-0.6-1.6-0.4-0.5-0.2-0.2-0.7-0.1-0.7 0-0.2-0.2-0.3-0.5-0.2-1.3-0.7-1.9-0.3-2.4-0.2-0.4-0.6-0.9-0.7-0.9-0.4-0.4-0.3-0.1-0.3-0.1-2.4 0.1-0.3 0-2.1 0.9-0.3 0.1-0.3 0-0.4-0.3-0.3-0.6-0.1-0.4 0.2-0.3 1.2-0.1 0.3-0.1 0.3-0.2 0.3-0.6 0-0.3-0.2-0.2-0.9-0.5-0.2-0.3 0-0.3 0.1-0.3 0.2-0.1 0.3 0 1 0.2 0.3 0 0.2-0.1 0.2-0.2 0.3-0.6 0.5-1.7 0.1-0.7-0.1-0.2-0.3-0.5-0.6-0.3-0.3-0.3-0.2-0.7 0-0.4 0.2-0.2 0.6-0.1 0.3-0.1 0.3-0.5 0.5-1.6 0.3-1-0.1-0.3-0.1-0.3-1-0.7-0.4-0.5-0.3-0.5 0-0.3 0.1-0.4 0.5-1.2 0.6-0.9 1.5-2.4 0.5-1.2 0.4-0.5 1.7-0.9 0.3-0.3 0.3-0.4 0.3-0.8 0.1-0.5 0-0.4-0.8-3-0.2-1.3-0.2-0.8-0.2-0.3-0.4-0.4-0.6-0.2-0.7-0.1-2.1 0.2-0.2 0-0.3-0.2-0.1-0.4 0-0.3 1.1-6.2-0.1-0.6-0.1-0.4-0.1-0.2-1.3-1.5-3.1-4.9-0.2-0.2-0.3-0.1-0.3 0-0.3-0.2-0.3-0.5-0.3-1.2 0.1-0.5 0.2-0.3 1.8 0 0.3 0.1 0.3 0.1 0.7 0.6 0.2 0.1 0.3 0 0.2-0.6 0-0.6-0.2-2 0-0.6 0.1-1 0.1-0.4 0.3-0.6 0.7-1.1 0.3-0.6 0-0.4 0-0.4-0.1-0.2-0.3-0.6-0.4-0.4-0.2-0.2-0.5-0.3-1.4-0.4-0.2-0.2-0.3-0.2-0.1-0.3-0.1-0.6-0.2-0.5-0.2-0.3-0.3-0.4-1.7-1.6-1-0.7-0.6-0.2-0.3 0-0.3 0-0.1 0.2-1.2 1.9-0.1 0.2-0.2 0.1-0.2-0.4-0.2-0.6-0.3-1.2-0.6-4.6-1-3.8 0-0.4 0.3-0.1 0.7-0.1 0.6-0.1 1-0.6 1.7-1.3 0.7-0.7 0.7-0.9 0.6-1.2 0.1-0.7 0.1-1 0.2-3.3-0.1-0.7 0-0.2-0.2-0.3-0.2 0.1-0.5 0.3-0.2 0.1-0.3-0.1-0.3-0.4-0.4-0.8 1.5-1.3 1.3-0.4 2.4 1 1.1 0 0.8-1.5 0.8-2.1 0.6-1.1 0.7-0.4 1.5-0.1 1.2-0.6 0.9-0.9 0.8-1.2 1.1-1.1 1.1-0.4 1.3-0.2 1.2 0.1 0.9 0.7 0.9 1.6 0.5 1.4 0.7 0.9 5.1 0.9 1-0.3 0.5-0.6 0.9-1.7 0.7-0.5 0.6 0.1 0.9 0.6 0.7-0.1 3.4-1.8 1.2-0.3 7.8-0.2 1.6 0.4 1.5 1.1 2.1 2.9 0.8 0.8 2.8 1.4 0.7 0.7 0.3 0.6 0.2 0.6 0 0.7 0 0.9-0.1 0.7-0.4 1.8-0.2 0.1 0.4 1 1.7 2.2 0.7 0.6 0.9 0.4 1.6 0 0.8 0.2 0.9 0.7 1.2 1.9 0.9 0.3 0.7-0.1 0.8 0.2 0.7 0.4 0.6 0.7 0.4 0.9 0.6-0.2 0.3-0.5z m15.9 57.9l-0.4-4.8-2.4-1.3-3-1.3-1.2 1.4 0.1 2.5-1.2 1.1-1.8 0.7-0.9 2.4-5.3-0.5-0.7 0.8 0.5 2-1.4-0.8-1.1-1.8-1.5-1.5-0.8-3-1.3-0.3-0.3-2.5-5.5 0 0 2.2-3.5 0.4 1.2 2.1-0.3 1.7-1-1.6-1 0.6 0.5 1.4 0 1.6-1.1 1-1.2 1.1-0.3 1.5 0.3 1.7-0.3 1.6-0.7 0.8 0.1 1.4 0.1 1.5-0.6 1.3-0.1 1.5-0.3 2.2 0.6 1.1 0.6-0.9 0.9 0.2 0.8-2.8 2.4 0.7 1.2-0.7 0.9 0.6 0.7 3.4 1.5 1.8 1.7 1.5 1.3 2.3-0.5 1.7 0.4 2 1 0 0.9-0.2 0.4 2 0.2 1.6 1 0.5 0.1 1.4 1-0.4 1.5 1.3-0.6 3.8 1.4 0 0.3 4.2 1.5 0.5 0.8 2.7 0.2 4 3.4-1.7-0.5-1.4 0.6-0.9-1.2-3.1-0.4-2.3 1.1-0.1 0.8-2.8-1-3.2-1.6-4.3 0.6-0.7-0.7-1.4 2-0.7 0.3 1.2 1.2-0.3 1.6 0.6 1.1-0.4-0.1-2.6 0.4-1.5 1.7 1.8 2.1-0.3-0.1-3.5 2.2 0 0.9-1.8-1.6-1.2-0.1-1.5-3.1-4.8-0.1-3.2 0.6-1-1-1.6 0.1-1.3 1.3-2 1.6-1 2.1-2.4z"
-        id="9" name="Kyiv" fill="{{ if (index .alerts 9) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="9" name="Kyiv" fill="{{ if (index .alerts "9") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M425.9 572.4l-4.3 1.8 2.8-1.5 1.2-0.9 0.4-0.8 0.3-0.7 0.7-0.3 0.9-0.1 0.7-0.3 0.9-0.7 1.6-1.8 0.9-0.8 8.7-8 2.8-1.5-12 11.5-5.6 4.1z m26.9-204.4l0 1.5 0.1 0.4 0.2 0.2 0.7 0.4 0.3 0.3 0 0.4-0.1 0.4-0.2 0.2-0.3 0.1-0.6-0.2-0.3 0-0.3 0-0.2 0.2-0.3 0.4-0.2 0.1-0.9-0.1-1.9 0.8-0.6 0.6-0.1 0.2 0.1 0.2 0.7 0.5 0.1 0.3 0.1 0.4-0.1 1.1 0.1 0.3-0.1 0.6-0.4 0.7-0.1 0.6 0 0.8 0.2 0.5 0.4 0.2 1.4 0.4 0.2 0.1 0.4 0.5 0.6 0.8 1 1.3 0.2 0.3 0.1 0.3 1.4 8.9 0.1 0.7 0.4 0.7 0.9 0.8 0.6 0.4 1.4 0.6 0.3 0.2 0.3 0.4 0.3 1.2 0 0.4-0.2 0.4-0.2 0.2-0.5 0.6-0.2 0.2-0.1 0.3 0 0.4 0.2 0.3 0.7 0.8 0.7 0.5 0.4 0.6 0 0.6-0.1 0.4-0.1 0.3-0.4 0.8-0.1 0.6 0.1 0.7 0.4 1 0.2 0.8 0.3 0.7 0.6 0.4 1.3 0.7 0.2 0.2 0.7 0.8 0.3 0.1 2.5-0.5 0.3 0 0.3 0.1 0.4 0.5 0.3 0.2 0.3 0 0.2 0 0.5-0.3 0.9-0.6 0.5-0.6 0.3-0.1 0.3 0 0.3 0.1 0.7 0.6 0.4 0.2 0.3 0 2 0.1 0.3 0.2 0.2 0.3 0.3 1.5 0.3 0.6 0.2 0.3 0.3 0.2 0.3 0 0.3-0.4 0.3-0.6 0.3-1.3 0-0.4 0.2-0.2 0.2-0.2 3.1-0.7 0.3 0.3 0.2 0.5 0.1 1.5 0 0.6-0.2 0.5-0.1 0.2 0 0.3 0.3 0.1 1.9 0.1 0.4 0.3 0.3 0.5 0.6 1.1 0.8 0.9 0.3 0.5 0.2 1.7 0 0.8-0.2 0.6-0.2 0.2-0.4 0.3-0.1 0.5 0.1 0.6-0.1 0.8-0.2 0.8-0.3 1.3-0.7 1.8 0.3 1.3 2.6 7.9 0.9 2.1 0.7 1.1 3.1 0.6 2.2 0.1 0.2 0 0.2 0.2 0.6 1.1 0.3 0.1 0.3 0 1.7-0.7 4.4-1 0.2 0.1 0.4 0.4 0.4 0.9 0.1 0.5 0.1 0.7-0.2 0.6-0.1 0.3-0.8 0.8-0.2 0.2-0.5 0.2-0.5 0.2-0.5 0.2-0.2 0.2-0.1 0.7 0 0.7 0.1 0.6 0.2 0.3 0.2 0.3 0.3 0.1 1.3 0.3 0.5 0.3 0.6 0.5 0.5 0.2 0.5-0.1 0.7-0.3 0.5-0.2 0.6 0 0.2 0.2 0.2 0.4 0.3 0.8 0.4 0.6 0.6 0.6 0.1 0.2 0.4 0.7 0.1 0.6 0.2 0.4 0.2 0.2 0.3 0.1 1 0.1 0.2 0.1 0.2 0.2 0.1 0.3 0.1 0.6-0.6 1.4 0 0.8 0 0.6-0.1 0.5-0.3 0.5-0.3 0.3-0.3 0.4-0.3 0.5-0.3 1.2-0.3 1-0.3 0.3-1.1 0.6-0.2 0.1-0.2 0.3-0.3 0.7-0.2 0.2-0.3 0-3.4 0.3-0.8 0.2-0.2-0.1-0.4-0.3-0.2-0.1-0.6 0-0.2 0.1-0.2 0.2-0.6 1-0.4 0.3-2.8 0.2-0.2 0-0.1 0.4 0 0.8 0.5 1.7 0.5 1 0.3 0.6 2.4 1.6 0.3 0.4 0.4 0.7 0.6 1.4 0.4 0.5 0.8 0.4 1 0.8 0.1 0.8 0.4 6.4 0.3 0.8 0 0.5 0.1 0.5-0.2 0.5-0.1 0.4 0 1.5 0.8 4.5-1.6 0-2.8 0.9-3 0.4-2.3 0.8-1.2 0.1-0.5 0.4-1.3 1.1-0.4-0.1-0.7-0.3-0.7 0.3-3.3 2.3-0.6 0.2-2.4-0.5-0.5-0.1-0.7 0.6-1.6 2.3-0.4 0.7 0.2 1.1 1 2 0.3 1.6 0.3 0 0.4 0.4 0.2 0.5-0.4 0.4-0.4 0.9-0.5 2.4-0.1 0.3-2.3 3.1-0.9 0.8-3-0.2-0.5 0.5 0.3 0.4 1.3 0.9 0.4 0.7 0 0.8-0.3 0.8-1.6 3.2-3.9 5.5-1.1 2.3-0.8 1.1-0.9 0.5-0.5 0.7-1.1 3.1-0.5 1.1-6.8 6.6-6.1 8.2-3.1 3.1-3.7 2.9 0.1-1.1-0.3-4.4-0.4-0.2-0.5 0.5-0.4 0.8-0.3 0.9-0.2 2.1-0.1 0.6-0.2 0.5 0 0.4-0.1 0.3-0.5 0.1-0.4-0.5-0.3-0.1-0.2 0.3-0.5 0.3-2.5-2.1-1.4-0.6-1.2 0.7-0.4 1.5-0.2 2-0.3 1.8-0.5-0.9-0.6-0.7 0-0.6 0.4-0.9-0.3-0.4-0.4 0.2-0.5 1.1 0 1.3 0.2 1.1 0.5 0.8 0.5 0.6-0.4 2.2-0.3-0.8-0.4-0.5-1.2-0.9-0.1-0.3 0-0.4 0-0.2-0.5-0.2-0.3 0.2-0.3 0.7-0.1 0.2-1.3 0-1.5 0.3-1.2 0.8-0.4 1.6 0.5 1.1 0.7 0.5 1.5 0.5 0.8 0.9 0.4 0.6-0.3 0.3-1 0.4-0.9 0.7-0.9 0.5-1.1-0.6-0.2 0.6-0.1 0.1 0 0.3 0.3 0.6-0.9 0.5-1.5 0.5-0.7 0.7 0.1-2.1-0.6-2.1-1.1-1.7-1.2-0.6 0.1-0.6-0.3-3.8 0.3-1.3 0.4-0.6 0.1-0.5-0.4-0.8-0.3-0.5-0.4-0.3-1.1-0.9-0.4-0.4-0.2-0.7-0.3 0.1-0.2 0.4-0.2 0.6 0.2 0.5 0.8 1.1 0.2 0.3-0.2 0.7-1.1 1.4-0.3 0.9 0 1.2 0.1 0.7 0.3 0.5 0.4 0.9-1.9 1.5-0.4 3.1 0.2 3.8-0.6 3.6 1.4 0.7 0.8 0.2 1.1 0.1 1-0.2 0.8-0.5 1.4-1.5-0.7 0.9-1.8 2.9-0.7 0.6-0.6 0.3-0.3 0.8-0.3 0.7-0.3 0.4-0.9 0.3 0 0.7 0.9 1.8 0.4 0.6 0.7 0.4 0.6-0.1 0.2-0.7-0.1-0.9 0.1-0.8 0.9-0.3 0 1.9 0.2 0.3 0.3 0 0.3-0.3 0-0.3 0.4 0.1 0.5 0 0.3 0.3 0.3 1.3 0.3-1.2 0.3-1.3 0.4-0.6 0.9 0.9 0.4 1.3 0 1.5-0.4 1.1-1.1-0.1 0.8 0.9 0.4 1.5 0.2 1.7 0.1 3.3 0 0.7-0.2 0.7-0.5 1.1-0.1 0.6-0.6 1.9-0.1 0.8 0 0.7 0 0.2-1.1 1-0.9 0.6-1 0.2-0.8-0.2 0.3-0.5 0.1-0.6-0.1-0.6-0.3-0.5 0.7-2.3-0.3-3-0.9-2.7-1.2-1.2-0.7-0.3-2.6-2.4-7.7-2.8-4.2-0.4-0.6-0.2-0.6-0.3-0.6-0.1-0.5 0.3-0.3 0.4-0.4 0.5-0.5 0.3-0.5 0.2-1.2 0-0.9-0.2-0.9 0.1-1.2 0.6-0.8 0.7-0.5 0.6-0.2 0.6-0.3 0.2-1.3 0.1-0.5 0.2-0.8 0.8-0.9 0.5-2.3 0.6-2.8 2.4-0.8 0.4-1.2 0-0.5 0.1-0.4 0.3-0.3 0.7-0.2 1.5-0.2 0.6-1.3 0.5-1.1-0.7-0.9-1-0.7-0.5-1.3-0.2-1.4-1-0.9-0.3-1.5 0.3 0 1.2 0.1 1.1-1.6 0.8-0.1 0.6 0.1 0.8 0.3 0.5 0.4 0.4 1.6 0.7-0.7 0.7-0.7 0.3-1.7 0.1-2.1 0.3-7.3-1.7-4.6-2.4-4.9-1.3-2-1.1-0.9-0.3-1.3-0.8-1.1-2-1.6-4.2 0.3-1.6-1.1-1.5-1.6-0.9-1.4 0.2-0.7-0.9 0.1-0.6 0.4-1 0.4-0.9 3-2.3 3.9 0.3 4.2 1.1 3.5 0.2 1.2-0.6 0.3-0.9-0.4-2.7-0.1-0.3 0.4-0.7 0.3-0.3 1.4-0.7-0.7-1-0.3-1.1-0.1-1.1-0.3-1.1-0.7-0.8-0.8-0.5-0.5-0.6 0.3-0.9 1.3-1.9 0.6-0.7 1-0.6 1.6-0.5 0.3-0.6-0.3-1.5 0.8-1.5 3.8-0.4 1.4-0.8 0.2-0.8-0.1-0.8-0.1-0.7 0-0.5 0.4-0.4 1.6-0.3 1.1-0.6 0.7-0.7 0.4-1 0-1.6-0.2-1.4-0.8-2.7 0.1-1.4 0.6-1.1 1-0.7 9.6-2.5 1.4-0.7 0.1-1.5-1-3.5-0.1-2 0.4-1.2 1.9-2.2 1.3-2.1 0.6-1.9-0.3-1.8-3.2-3.1-1-1.3 0-1 0.4-1.2 0.3-1.4-0.1-1.3-0.9-3.8-0.5-2.9 0.4-2.3 1.1-1.8 4.1-2.8 3-1.1 4.9-2.8 1.2 0 0.9 1.2 0.3 1.7 0.1 6.8-0.1 0.9-0.4 0.8-0.5 0.8-0.4 0.7 0.1 0.9 0.9 0.8 1.2-0.8 2-2.2 0.7-0.3 0.4 0.2 0.3-0.2 0.3-1 0.1-0.7-0.3-1.6 0.2-1 1-1.7 0.7 0.3 1.3 2.8 1 1.5 0.7-0.1 2.4-3.7 1.1-1.1 1.1-0.7 1.3 0.2 0.3 0.5 0.1 0.6-0.1 0.5-0.6 1.2-0.1 0.6 0.1 0.6 0.3 0.6 0.8 0.4 1.8 0.3 0.8 0.3 0.7 0.7 0.8 1.8 0.8 1 0.8 0.5 1 0.1 0.9-0.3 0.7-0.8 0.4-1.3-0.2-2 0.3-0.5 1.6 0 1.1-0.4 0.6-1.2 0-2.3 0.7 1.3 2.9 2.7 1.2 1.9 0.3 0.6 0 0.8 0 1.5 0.1 0.6 1.1 1.2 1.1-0.2 2.1-1.8 0.9-0.6 0.9-0.1 6.6 0.3 2.4-0.4 1.5-1.4 1.3-2.5-0.7-0.5-0.9-0.1-0.8 0.1-0.6 0.5-0.9-1.1-2.6-2 0.4-0.7-0.6-1.4-0.4-0.5-0.4-0.4-0.4 0-0.6 0.4-0.4 0.2-0.2-0.2-0.3-0.8-0.1-0.2-2.4-1-0.8-1-0.2-1.8 1-0.1 1.2-0.2 0.7-1.8-0.2-0.7-0.7-1.7-0.2-0.8 0.1-1.1 0.2-0.7 0.2-0.8 0.3-0.9 0.4-5-0.1-1.5-0.2-0.6-0.6-1.2-0.2-0.6-0.1-0.7 0-1.8-0.1-0.5-0.6-0.3-0.6 0.2-0.5 0.4-0.5-0.1-0.2-0.4-0.5-1.5-0.2-0.6-1.6-1.3-1.6-0.2-1.6 0.2-1.8-0.3-1-0.8-1.3-2.1-0.9-0.9-0.8-0.3-1.8-0.2-0.9-0.3-0.5-0.4-0.9-1-0.5-0.3-1.4 0.2-0.3-0.1-0.5-0.9 0.1-1.1 0.3-1.3 0.4-3 0.6-0.9 0.6-0.7 0.5-1.1-0.1-1.3-0.4-1.1-0.6-0.9-0.8-0.5-1 0.1-0.6 1-0.5 1-0.6 0.5-0.5-0.5-1.7-3.2-0.2-0.5 0.1-0.2 0.1-0.3 1.5-0.1 1.2-0.4 0.8-0.9 0.4-2.1-0.6-4.2 0.2-1.8 1.4-0.7 0.6-1.4 0-1.9-0.6-1.9-0.7-1.4-0.9-0.9-1.1-0.3-1.1-0.1-1.1 0.1-0.6 1.3-0.2 1.2-0.4 1.2-1 0.9-0.9 0.3-0.8-0.1-0.9-0.4-0.7-0.7-0.8-1.2-0.1-1.2-0.1-1.2-0.2-1.3-0.8-1-0.8-0.4-0.9-0.2-0.8-0.4-0.4-0.9-0.3-1-0.3-0.9-1.7-1.1-1-1.7-0.8-0.5-0.9 0.5-0.6 0.8-0.5 0.5-1-0.8-0.5-0.8-0.9-2.5-0.5-1.1-0.7-3.3 0.7-2.1 1.4-1.9 1.5-2.5 0.5-3.1 0-2.9 0.3-2.6 1.6-2.1 0.7-1.1-0.2-0.9-0.7-0.6-1.9-0.7-0.6-0.5 0-0.9 0.5-1.5 0.7-0.9 1-1.1 0.9-1.2 0.1-1.1-0.5-0.4-2.2-0.7-0.8-0.6-0.4-1.2-0.5-2.8-0.4-1.3-0.7-1.1-0.7-0.6-0.7-0.3-1-0.1-1.7 0.9-2.5 3.2-2 0.4-1.7-0.7-1.9-1.5-1.8-2-1.2-2 1-0.8 4.7-2.1 1.2-0.8 0.6-0.9 0.7-0.8 0.5-0.4 0.9-0.5 0.6-0.5 0.2-0.2 0.1-0.3-0.1-0.6-0.4-2.1 0-0.5 0-0.3 0.2-0.3 0.2-0.1 1.6-0.7 0.5-0.1 0.3 0 0.6 0.2 0.3 0.2 0.5 0.4 1 0.9 0.7 0.5 0.2 0.2 0.5 0.6 0.5 0.3 2.9 1.2 1 0.5 0.3 0.1 5.1-0.2 5.3-2.2 0.9 0 0.5 0.4 2.1 1.3 0.7 0.2 4.2 0.3 3.1-0.3 0.5-0.2 0.3-0.1 0.3 0.1 0.5 0.2 0.3 0 0.2-0.2 0.9-2.7 0.1-0.5 0-0.4-0.3-0.5-1.1-1.5-0.3-0.5-0.1-0.2 0.1-0.4 0.2-0.3 0.7-0.4 0.5 0.1 0.4 0.1 0.5-0.1 0.5-0.1 3.2-1.8 1.4-0.2 0.9-0.4 0.2 0 0.3 0.1 0.3 0.1 1 0.8 1 1.3 0.3 0.2 0.2 0.1 0.3 0 0.3-0.2 0.3-0.3 1.2-2.3 0.4-0.5 0.1-0.2 0.3-0.1 0.4 0.1 0.7 0.7 0.3 0.3 0.3 0 0.5-0.1 0.7-0.5 0.9-0.3 0.9 1.8 0.8 2 1.1 1.6 2 1 11.6 0.6z m-9.1 143.8l-0.7 1.3-0.1 0.6-0.2 0.5-0.1 0.5 0.1 0.9 0.2 0.5 0.6 1.3 3.7 4.2 1 0.5 1.2 0.2 3.9 1.6 2.1 1.7 1.4 2.1 3.2 6.8 0.4 0.6 0.7 0.2 0.4-0.2 1.4-1.3 0.2-0.4 0.2-0.4 0.4-0.6 0.4-0.6 0.2-0.9 0-0.6-0.2-0.2-0.4-0.3-0.6-0.5-3.2-3.1-0.5-1 0.1-0.7 0.2-1.1 0-0.7-0.2-0.8-0.1-0.5-0.3-0.4-0.5-0.5-3.3-1.9-0.5-1.1 0-1.6-0.2-1.6-0.3-1.5-0.6-1-0.3 1.9-0.2 2-0.3 1.2-1.1-0.2-0.9-0.9-0.9-1.4-0.5-1.7 0.4-1.5-0.3-0.5-0.4-0.4-0.6-0.3-0.6 0-4.3 1.8z"
-        id="14" name="Odesa" fill="{{ if (index .alerts 14) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="14" name="Odesa" fill="{{ if (index .alerts "14") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M405.9 240.4l2.8 0.4 0.3 0.2 0.5 0.3 0.1 0.3 0.1 0.3-0.1 0.6-0.2 0.8-0.6 1.1-0.1 0.3-0.1 0.7 0 0.7 0.1 0.9 0.1 0.4 0.2 0.5 0.4 0.6 0.4 0.4 0.3 0.2 2.2 1.1 0.2 0.3 0.1 0.4 0 0.6-0.1 1-0.2 0.4-0.4 0.3-0.4 0.2-0.3 0.3-0.1 0.3 0 0.3 0.2 0.5 1.2 2.6 0.2 0.7 0 1.1 0 0.7-0.3 0.6-0.2 0.2-2.7 1.8-0.4 0.4-0.2 0.4-0.1 0.6 0.1 0.8 0.8 1.6 0.2 0.6 0.2 0.5 0 0.7 0.2 0.3 0.3 0.4 0.8 0.4 0.6 0.2 1.6 0.2 0.2 0.1 0.2 0.3 0.3 0.3 0.7 1.7 1 1.5 0.4 0.8 0.1 0.4 0.2 0.2 0.4 0.2 0.7 0 0.9-0.1 0.6 0.3 0.5 1.2-1.3 1.4 0 0.7 0.3 0.1 0.8 0.5 0.3 0.2 0.3 0.3 0.3 0.5 0.1 0.4 0 0.4-0.4 0.6-0.2 0.4 0 0.4 0.1 0.4 0.1 0.5 0.2 0.9-0.1 0.4-0.1 0.2-1.1 0.6-0.2 0.2-0.4 0.4 0 0.4 0.1 1.4-0.1 0.4-0.2 0.3-1 0.4-1.9 1.2-0.5 0.3-0.6 1-0.2 0.5-0.1 0.5 0 0.6 0.2 0.7 0.4 0.5 0.5 0.3 0.3 0.1 1.7 0 0.6 0.2 0.4 0.4 0.3 0.6 0.1 0.9-0.1 0.4-0.2 0.2-0.3 0-0.3 0.2-0.3 0.3-0.4 1.2-0.2 0.4-0.3 0.5 0 0.3 0.1 0.2 1.1 0.6 0.8 0.7 0.2 0.1 0.9 0 0.3 0.1 0.5 0.3 0.4 0.4 0.3 0.7 0.2 0.7 0 0.4-0.2 0.3-0.6 0.5-0.2 0.2-0.2 0.4 0 0.4 0.1 0.7 0.3 0.7 0.4 0.4 1.4 0.3 0.2 0.2 0.3 0.7 1 5.2 0.2 0.7 0.3 0.1 2 0.3 1.5-0.1 0.3 0.1 0.4 0.2 0.5 0.7 0.3 0.3 0.4 0.3 0.3 0.1 0.2 0.2 0.3 0.3-0.1 0.3-0.1 0.2-0.2 0.2-0.9 0.5-0.3 0.3-0.1 0.4-0.1 0.8 0 0.4 0.1 0.4 0.4 0.4 0.4 0.4 1.8 1.3 2.4 1 0.4 0.2 0.1 0.3 0.1 0.3 0 2.4 0.1 0.7 0.1 0.4 0.5 1 0.6 0.9 1 1.3 0.8 1.7 0.3 1.1 0 0.4 0 0.7-0.2 0.4-0.3 0.4-0.3 0.4-0.2 0.1-0.4 0-0.1 0.3-0.1 0.5-0.2 0.4-0.3 0.5-0.2 0.2-1 0.9-0.1 0.2-0.1 0.3-0.1 0.6 0.1 0.3 0.2 0.2 0.2 0 0.5-0.1 2.6-1.1 0.6-0.2 0.6 0 0.5 0.2 0.5 0.5 0.9 0.8-1.4 1.9-0.7 0.4-1.9 0.3-0.4 0.2-0.2 0.3-0.8 1.4-1.2 1.5-0.6 0.5-0.4 0.2-0.1-0.2-0.3-0.5-0.3-1.4-0.1-0.3-0.4-0.7-0.1-0.3 0.1-0.7-0.1-0.3-0.2-0.3-0.8-0.2-0.5 0-0.3 0.1-1 0.9-0.4 0.4-0.6 1-0.3 0.5-1.2 1.5-1.8 1.7-0.5 0.7-0.1 0.4 0.1 0.8-0.1 0.7 0 0.7-0.1 0.4-0.4 1.2-0.4 0.6-1 1.5-0.3 0.6 0.1 0.2 0.1 0.2 0.5 0.3 1.4 0.4 0.5 0.2 0.1 0.3-0.2 0.8 0.3 0.7-0.1 0.3-0.1 0.4-0.3 0.4-0.3 0.7 0.1 0.6-3.2 1.8-0.5 0.1-0.5 0.1-0.4-0.1-0.5-0.1-0.7 0.4-0.2 0.3-0.1 0.4 0.1 0.2 0.3 0.5 1.1 1.5 0.3 0.5 0 0.4-0.1 0.5-0.9 2.7-0.2 0.2-0.3 0-0.5-0.2-0.3-0.1-0.3 0.1-0.5 0.2-3.1 0.3-4.2-0.3-0.7-0.2-2.1-1.3-0.5-0.4-0.9 0-5.3 2.2-5.1 0.2-0.3-0.1-1-0.5-2.9-1.2-0.5-0.3-0.5-0.6-0.2-0.2-0.7-0.5-1-0.9-0.5-0.4-0.3-0.2-0.6-0.2-0.3 0-0.5 0.1-1.6 0.7-0.2 0.1-0.2 0.3 0 0.3 0 0.5 0.4 2.1 0.1 0.6-0.1 0.3-0.2 0.2-0.6 0.5-0.9 0.5-0.5 0.4-0.7 0.8-0.6 0.9-1.2 0.8-4.7 2.1-1 0.8-0.3-0.6-0.9-0.8-0.4-0.6-0.1-0.5-0.2-2.1-0.9-2.2-0.1-0.6 0-0.5-0.2-0.6-0.3-0.7-1.6-1-2-0.4-3.8 0-5.1-2.2-1.8 0-1.2 0.5-1 3-0.4 1.8 0 1.4-0.3 0.8-0.5 0-1.1-0.8-0.7-0.7-1.6-3.2 0.9-1.1 0.1-1.2-0.5-1.2-0.9-0.6-1.3 0.2-0.7 1-0.6 1.3-0.8 0.9-1.2 0.3-0.6-0.7-0.1-1.2 0.4-1.1 0.8-1.1 0.8-0.5 0.6-0.8 0.4-1.7-0.1-1.5-0.6-0.7-0.9-0.1-2.6 0.3-0.9 0.6-0.9 0.7-1.2 0.7-1.3 0.3-0.9-0.3-0.6-0.9-0.2-1.6-0.4-1.4-0.9 0.1-1.7 1.5-0.9 0.2-1.2 0-0.8-0.6 1.1-3.3-0.3-1.5-1-1.1-1.1-0.5-4.9-0.6-2.3-0.9-1.2-1.9-0.4-0.4-1.8-2.7-0.8-0.9-3.6-2.6-1.8-0.9-6.9 0.1-1.1-0.6-0.2-2.2-1.2-0.1-1.4 0.9-0.7 0.2-2.2 0-0.6-0.8-1.2-5.5-0.8-2.5-1.1-2.1-1.6-1.5-2.1-0.8 1.2-4.5 0.4-2.1 0.1-1.9 0-0.4-0.2-0.4-0.1-0.9 0-0.8 0.1-0.3 0.3-0.1 0.6 0 0.3 0 0.3-0.3 0-0.3 0-0.3-0.3-0.5-0.3-0.5-0.4-1.1-0.2-1.1-0.2-4.6-0.1-0.6-0.2-1.2-0.4-1.4-0.6-1-0.2-0.9-0.1-0.4 0.1-0.4 0.2-0.2 0.5-0.3 0.3-0.2 0.3-0.4 0.1-0.4 0-0.3-0.1-0.2-0.5-0.8-0.2-0.4-0.2-0.8 0-0.4 0.1-0.4 0.3-0.3 0.3-1.1 0.1-0.4 0.2-0.3 0.4-0.2 0.6-0.6 0.4-0.3 0.4-0.4 0.3-0.7 0.2-0.9 0.3-0.5 0.2-0.2 0.9-0.4 0.3-0.4 0.1-0.4 0-0.7 0.1-0.3 0.2-0.5 0.2-0.6 0.4-1.1 0.4-0.4 0.3-0.3 1.3 0 2.4 0.4 0.3-0.1 0.4-0.3 1.2-2.2 0.4-0.4 0.3-0.2 1.4 0.3 0.8 0.4 0.9 0.6 0.6 0.4 0.3 0.1 1 0.1 0.6 0.3 1.1 0.8 0.5 0.3 0.6 0.3 0.6 0.1 0.6-0.1 0.3-0.3 1.1-1.4 0.4-0.4 1.3-0.6 1.8-0.1 0.4-0.1 0.6-0.3 0.2-0.3 0.1-0.4-0.1-0.2-0.2-0.6-0.1-0.6-0.1-0.6 0-0.7 0-0.7-0.1-0.6-0.3-0.5-0.6-0.9-0.3-0.5-0.1-0.8 0.1-0.6 0.2-0.5 0.3-0.5 0.4-0.6 0.2-0.4 0-0.4-0.3-0.7-0.7-0.9-0.2-0.2-0.2-0.5 0-0.8 0.1-0.8 0-0.4-0.1-0.2-0.2-0.2-0.2-0.2-0.9-0.2-0.5-0.3-0.2-0.6-0.1-0.9-0.1-0.3-0.2-0.6-0.2-0.3-0.1-0.5 0.1-0.4 0.2-0.3 1.7-0.6 0.3-0.2 0.1-0.3 0-0.3-0.4-1.1-0.2-0.5-0.3-0.5-0.2-0.2-3.4 0-0.7-0.1-0.3-0.2-0.2-0.2-0.2-0.3-0.1-0.6 0.1-0.4 0.1-0.3 0.2-0.2 0.7-0.5 0.3-0.3 0-0.2-0.1-0.3-0.2-0.2-1.6-1.2-0.1-0.4-0.1-0.5 0-1.3 0.2-0.6 0.2-0.4 0.2-0.1 0.6-0.1 0.3 0.1 0.5 0.3 0.4 0 0.4-0.3 0.5-0.7 0.1-0.5 0-0.4-0.2-0.2-0.6-0.6-0.2-0.3-0.1-0.3 0-0.3 0.2-0.3 0.5-0.7 1.7-3.4 0.2-0.6 0.1-0.5 0-0.3-0.2-0.6-0.2-0.5-0.4-0.4-0.4-0.4-0.5-0.3-1.4-0.7-0.2-0.2-0.2-0.3-0.1-0.4 0-0.4 0.1-0.3 0.6-1.1 0.1-0.3 0.2-0.1 0.3 0 1.4 0.3 0.3 0 0.5-0.3 0.5-0.4 0.4-0.5 0.4-0.6 0-0.3-0.2-0.3-1-0.7-0.2-0.2 0.1-0.1 0.2-0.1 1.1-0.1 0.7-0.2 1-1.3 4.9-0.6 3.8 0.6 1.8-0.2 4.7-1.3 5.4-0.2 0.5-0.2 1-0.5 0.5 0 0.6 0.1 2.6 1.3 0.5 0 0.6 0 1.1-0.1 0.9-0.3 0.5-0.5 1-1.9 0.2-0.2 0.6-0.1 4.9 0.1 0.5 0.1 1.4 0.8 0.3 0.3 0.4 0.4 0.2 0.1 1.2-0.2 0.8-0.3 1-0.6 0.3-0.1 0.3 0 4.2 0.7 0.5-0.1 0.3-0.2 0.3-0.5 0.4-1.2 0.7-1.4 0.4-0.4 0.7-0.5 1.3-0.5 0.5-0.3 0.4-0.4 0.3-0.5 0.3-0.6 0.1-0.7 0.3-0.2 0.4-0.1 3.5 0.5 0.3 0.1 0.3 0.2 0.1 0.3-0.2 0.6 0.1 0.4 0.3 0.5 0.9 0.9 0.3 0.5 0.1 0.3-0.2 0.8 0.1 0.3 0.2 0.9 0 0.3 0 0.7 0 0.3 0.1 0.4 0.9 2 0.5 0.7 0.3 0.4 1.6 1.2 0.1 0.3 0 0.3-2.6 3.4-0.3 0.5-0.2 0.6 0 0.4 0.3 0.2 1 0.8 0.3 0.4 0.1 0.4 0.1 0.6 0.1 0.3 0.7 1.1 0.1 0.3 0 0.2-0.2 0.6-0.6 0.6-0.1 0.2-0.1 0.3 0.2 0.5 0.6 0.4 0.5 0.2 1.2 0.3 2.1-0.2 3.9 0.7 5.9-0.4 1.1 0.1 0.3 0 0.3-0.1 0.1-0.4 0-0.8 0.2-0.7 0.4-0.3 0.7-0.2 2.3-0.3 1 0.1 0.5-0.2 0.5-0.3 1.8-1.7 0.4-0.5 0.6-0.3 1.3 0.1z"
-        id="1" name="Vinnytsya" fill="{{ if (index .alerts 1) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="1" name="Vinnytsya" fill="{{ if (index .alerts "1") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M400.7 90l0.4 0.8 0.3 0.4 0.3 0.1 0.2-0.1 0.5-0.3 0.2-0.1 0.2 0.3 0 0.2 0.1 0.7-0.2 3.3-0.1 1-0.1 0.7-0.6 1.2-0.7 0.9-0.7 0.7-1.7 1.3-1 0.6-0.6 0.1-0.7 0.1-0.3 0.1 0 0.4 1 3.8 0.6 4.6 0.3 1.2 0.2 0.6 0.2 0.4 0.2-0.1 0.1-0.2 1.2-1.9 0.1-0.2 0.3 0 0.3 0 0.6 0.2 1 0.7 1.7 1.6 0.3 0.4 0.2 0.3 0.2 0.5 0.1 0.6 0.1 0.3 0.3 0.2 0.2 0.2 1.4 0.4 0.5 0.3 0.2 0.2 0.4 0.4 0.3 0.6 0.1 0.2 0 0.4 0 0.4-0.3 0.6-0.7 1.1-0.3 0.6-0.1 0.4-0.1 1 0 0.6 0.2 2 0 0.6-0.2 0.6-0.3 0-0.2-0.1-0.7-0.6-0.3-0.1-0.3-0.1-1.8 0-0.2 0.3-0.1 0.5 0.3 1.2 0.3 0.5 0.3 0.2 0.3 0 0.3 0.1 0.2 0.2 3.1 4.9 1.3 1.5 0.1 0.2 0.1 0.4 0.1 0.6-1.1 6.2 0 0.3 0.1 0.4 0.3 0.2 0.2 0 2.1-0.2 0.7 0.1 0.6 0.2 0.4 0.4 0.2 0.3 0.2 0.8 0.2 1.3 0.8 3 0 0.4-0.1 0.5-0.3 0.8-0.3 0.4-0.3 0.3-1.7 0.9-0.4 0.5-0.5 1.2-1.5 2.4-0.6 0.9-0.5 1.2-0.1 0.4 0 0.3 0.3 0.5 0.4 0.5 1 0.7 0.1 0.3 0.1 0.3-0.3 1-0.5 1.6-0.3 0.5-0.3 0.1-0.6 0.1-0.2 0.2 0 0.4 0.2 0.7 0.3 0.3 0.6 0.3 0.3 0.5 0.1 0.2-0.1 0.7-0.5 1.7-0.3 0.6-0.2 0.2-0.2 0.1-0.3 0-1-0.2-0.3 0-0.2 0.1-0.1 0.3 0 0.3 0.2 0.3 0.9 0.5 0.2 0.2 0 0.3-0.3 0.6-0.3 0.2-0.3 0.1-1.2 0.1-0.2 0.3 0.1 0.4 0.3 0.6 0.4 0.3 0.3 0 0.3-0.1 2.1-0.9 0.3 0 2.4-0.1 0.3 0.1 0.3 0.1 0.4 0.4 0.7 0.9 0.6 0.9 0.2 0.4 0.3 2.4 0.7 1.9 0.2 1.3 0.3 0.5 0.2 0.2 0.7 0 0.7 0.1 0.2 0.2 0.4 0.5 0.6 1.6 0.5 0.9 0.4 0.4 0.2 0.1 0.7 0.3 0.2 0.4 0 0.8-0.3 3.4 0.1 1-0.1 1.9 0.1 0.3 0.2 0.5 0.1 0.3 0.4 0.3 0.8 0.5 0.2 0.2 0.2 0.4 0 0.6 0 1.1 0.3 0.5 0.1 0.4 0 0.4-0.4 0.9-0.4 0.4-0.4 0.2-1.8 0.5-0.3 0.1-0.2 0.3-0.1 0.4 0 0.5 0.1 0.8 0.8 3.2-0.2 0.5-0.3 0.4-0.2 0.3 0 0.6 0.2 0.7 3.2 5.2 0.1 0.6-0.1 0.5-0.3 0.5-1.3 1.4-0.4 0.3-1.5 0.8-0.2 0.2-0.3 0.4-0.4 0.9-0.9 1.2-0.4 0.4-0.3 0.2-0.8 0.3-0.3 0.1-0.6 0.7-0.4 0.3-1.8 0.8-2 1.5-0.5 0.1-0.5-0.1-0.3 0.1-0.3 0.2-0.3 0.6-0.3 0.3-0.4 0.2-0.7-0.1-0.3 0-0.2 0.1-0.5 0.3-0.1 0.3-0.1 0.4 0.2 0.7 0.1 0.4 0.3 0.6 0.1 0.5-0.1 0.6-0.2 1.1-0.3 1-0.4 0.8-0.3 0.5-0.4 0.5 0 0.4 0.7 0.1 0.7 0 1.3-0.2 0.6 0 0.1 0.2 0.1 0.3 0 0.5 0 0.7-0.1 0.4-0.2 0.4-0.2 0.4-0.8 0.4-0.5 0.4-0.2 1.3-1.3-0.1-0.6 0.3-0.4 0.5-1.8 1.7-0.5 0.3-0.5 0.2-1-0.1-2.3 0.3-0.7 0.2-0.4 0.3-0.2 0.7 0 0.8-0.1 0.4-0.3 0.1-0.3 0-1.1-0.1-5.9 0.4-3.9-0.7-2.1 0.2-1.2-0.3-0.5-0.2-0.6-0.4-0.2-0.5 0.1-0.3 0.1-0.2 0.6-0.6 0.2-0.6 0-0.2-0.1-0.3-0.7-1.1-0.1-0.3-0.1-0.6-0.1-0.4-0.3-0.4-1-0.8-0.3-0.2 0-0.4 0.2-0.6 0.3-0.5 2.6-3.4 0-0.3-0.1-0.3-1.6-1.2-0.3-0.4-0.5-0.7-0.9-2-0.1-0.4 0-0.3 0-0.7 0-0.3-0.2-0.9-0.1-0.3 0.2-0.8-0.1-0.3-0.3-0.5-0.9-0.9-0.3-0.5-0.1-0.4 0.2-0.6-0.1-0.3-0.3-0.2-0.3-0.1-3.5-0.5-0.4 0.1-0.3 0.2-0.1 0.7-0.3 0.6-0.3 0.5-0.4 0.4-0.5 0.3-1.3 0.5-0.7 0.5-0.4 0.4-0.7 1.4-0.4 1.2-0.3 0.5-0.3 0.2-0.5 0.1-4.2-0.7-0.3 0-0.3 0.1-1 0.6-0.8 0.3-1.2 0.2-0.2-0.1-0.4-0.4-0.3-0.3-1.4-0.8-0.5-0.1-4.9-0.1-0.6 0.1-0.2 0.2-1 1.9-0.5 0.5-0.9 0.3-1.1 0.1-0.6 0-0.5 0-2.6-1.3-0.6-0.1-0.5 0-1 0.5-0.5 0.2-5.4 0.2-4.7 1.3-1.8 0.2-3.8-0.6-4.9 0.6-11.5-3.3-0.3-0.4-0.3-0.5-0.1-0.3-0.1-0.6-0.2-0.6-0.6-0.9-1.5-2-0.3-0.5-0.1-0.6 0.2-0.9 0-0.3-0.2-0.5-0.2-0.2-0.3-0.2-2-0.8-0.4-0.3-0.2-0.4 0.2-1.9 0.1-0.3 0.1-0.2 0.3-0.1 1-0.1 0.3-0.1 0.2-0.2 0-0.2-0.1-0.6-0.2-0.6-1.6-2.8-0.1-0.5 0-0.3 0.2-0.1 2.7 0.5 0.4-0.1 0.6-0.1 0.4-0.4 0.3-0.4 0.4-0.4 0.3-0.2 0.9-0.1 0.2-0.2 0.2-0.7 0.1-1-0.1-0.8-0.2-0.4-0.2-0.3-0.2-0.1-1.1-0.5-0.4-0.3-0.2-0.3-0.1-0.3 0.1-0.5 0.3-0.9 0.3-0.6 0.7-0.9 0.1-0.4-0.1-0.2-0.2-0.3-0.7-0.6-0.1-0.4 0-0.3 0.1-0.3 0.2-0.1 0.5-0.3 0.3-0.2 0.1-0.4 0-0.7-0.2-0.7-0.6-0.9-0.9-1-0.4-0.6-0.2-0.4-0.1-0.3 0-0.4 0.2-2 0-0.6-0.1-0.7-0.2-0.4-0.3-0.3-0.3 0-0.4 0-0.2 0.2-0.4 0.8-0.5 0.7-0.4 1-0.3 0.5-0.2 0.1-0.3 0.1-2.5-0.3-0.2 0-0.4-0.2-0.1-0.4-0.1-1.1-0.1-0.3-0.1-0.3-0.5-0.4-1.1-0.3-0.5-0.3-0.3-0.4-0.3-0.7-0.3-0.2-0.3-0.1-0.6 0.1-0.4 0-0.2-0.2-0.1-0.3 0.1-0.3 0.3-0.5 0.2-0.3-0.1-0.3-0.2-0.2-0.5-0.3-1.8-1.4-2.4-1.4-0.6-0.5-0.3-0.4-0.2-0.4-0.2-1-0.2-0.4-0.2-0.2-0.5-0.3-1.5-0.3-0.4-0.1-0.5-0.4-0.3-0.2-0.1-0.4 0-0.7 1.1-4 0.3-0.6 0.3-0.5 0.4-0.4 0.5-0.3 1.2-0.7 0.4-0.4 0-0.3-0.5-0.3-1.1-0.3-0.4-0.1-0.4-0.4-0.3-0.4-0.2-0.5-0.3-0.8-0.4-0.3-0.8-0.6-0.7-1.1-0.3-0.3-0.8-0.4 2-2.3 0.4-1.3-0.1-0.4-0.4-0.4-1.1-0.6-0.3-0.2-0.2-0.4-0.3-0.7 0-0.4 0.2-0.5 0.3-0.9 0.6-1.6 0.2-0.4 1.1-1.4 0.4-0.8 1.3-3.3 0.2-0.9 0.1-0.6-0.2-0.9-0.4-0.8-1.1-1.7-0.5-1-0.3-0.7-0.1-0.5-0.1-1 0-0.6 0.1-0.5 0.8-4.2 0.1-0.9-0.1-0.6-0.2-0.6-0.7-0.9-0.2-0.3-0.2-0.5 0.1-0.3 0.6-0.2 0.2-0.3 0.3-0.5-0.1-0.3-0.1-0.3-0.4-0.4-0.2-0.3-0.2-0.4-0.1-0.8 0-0.5-0.1-0.4-0.6-1.1-0.4-0.8 0.1-0.4 0.4-0.4 0.5-1.8 0.2-0.4 0.2-0.2 0.5-0.3 2.2-0.5 1.3-0.6 0.2-0.3 0.3-0.5 0.7-1.6 0.2-0.4 0.2-0.2 0.6-0.6 0.5-0.7 0.3-0.7 0.2-0.6 0.1-2 0.1-0.6 0.1-0.5 0.1-0.3 0.5-0.6 0.4-0.4 0.2-0.4 0.3-0.5 0.7-2.2 0.3-0.5 0.2-0.2 1.3-0.8 0.3-0.3 0.3-0.4 0.4-1 0.2-0.6 0.1-0.5 0-0.4-0.1-0.5-0.1-0.4-1.8-3.8-0.1-0.4 0.1-0.2 0.2-0.2 3.1-0.7 0.5-0.3 0.6-0.6 0.3-0.7 0.4-0.8 0.1-0.5 0-0.4-0.3-0.5-0.9-0.8-0.1-0.8 0-1.1 0.6-3.9 0.2-0.7 0.1-0.2 0.3-0.1 0.3 0.1 0.2 0.1 0.4 0.4 0.3 0.5 0.3 0.9 0.4 0.5 2.2 1.9 0.2 0.2 0.3 0 0.4 0 0.1-0.3 0.1-0.3 0-0.4-0.2-0.5-0.3-0.5-0.9-0.9-0.1-0.5-0.1-0.7 0.2-1.7 0.2-0.8 0.2-0.6 0.2-0.1 0.5-0.3 0.6-0.2 1.3-0.7 1.8-0.3 0.3 0.5 0.7 0.8 0.9 0.8 0.8 0.3 0.9-0.1 0.6-0.6 2.5-3.5 0.3-0.6 0.3-1.2-0.1-0.9-0.1-1 0-1.1 0.3-1.8 0.7-1.5 1.1-1 1.2-0.2 1.2 0.6 3.4 3.7 1 0.6 1 0.2 5.4 0 1.1-0.4 1.3-1.2 1.8-3.4 1.1-1.7 1.2-1.1 1.2-0.6 1.2 0.1 0.8 0.9 0.2 0.9 0 0.8 0 0.8 0.5 1 0.7 0.7 1.5 1 0.7 0.7 0.4 1 0.6 2.4 0.3 0.7 0.7 0.3 0.7-0.3 1.5-1.4 2.7-1.9 1.5-0.5 1.5 0 4.9 1 1.5 0.6 0.4 1.2 0.2 1.8 0.8 5 0.4 1.2 0.5 1 1 0.4 0.8-0.3 0.7-0.5 0.7 0.1 0.4 1.1 0.4 1.6 0.6 0.9 1-1.1 0.2-1.3-0.1-3.4 0.2-1.5 1.1-2.4 1.5-1.9 1.8-1.4 1.7-1 1.7-0.3 3.4-0.1 1.4-0.5 1.1-1.2 1.3-2.8 1.1-1.1 1.1-0.4 1.1 0 2.2 0.5 1.4 0.9 0.7 1.1 1.1 3.2 1.9 2.8 0.6 1.5 0.2 2.3-0.5 2.3 0.4 1 0.9 0.7 1.1 1.3 0.7 1.7 0.4 2 0.7 1.6 1.3 0.7 1.1-0.4 0.7-0.6z"
-        id="5" name="Zhytomyr" fill="{{ if (index .alerts 5) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="5" name="Zhytomyr" fill="{{ if (index .alerts "5") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M751.7 180.6l-0.1 0.5-0.2 0.7-0.3 0.6-0.2 0.2-0.4 0.1-0.5 0.1-0.9 0.1-1-0.2-1.8-0.5-0.4 0.1-0.6 0.1-0.8 0.4-1.7 0.2-1.7-0.4-0.3 0.2 0 0.4 0.1 0.2 0.4 0.5 0.2 0.2-0.1 0.2-0.2 0.1-0.5-0.1-3.8-2.4-0.6-0.3-0.3-0.1-0.3 0-0.3 0.2-0.3 0.2-0.1 0.5 0 0.4 0 1.3 0 0.4-0.1 0.3-0.2 0.2-0.3 0.1-0.4 0-0.4-0.2-1.3-0.8-0.3-0.1-0.4 0.1-0.5 0.2-0.7 0.7-0.1 0.4 0 0.3 0.3 0.4 0 0.2-0.1 0.2-0.3 0.2-0.3 0-1.7-0.4-0.2 0.1-0.3 0.2-0.4 0.6-0.1 0.3-0.1 0.4 0.3 0.8 0.1 0.6-0.1 0.3-0.2 0.2-0.5 0.2-1.9 0.4-0.7-0.1-0.4-0.1-0.3-0.2-1.1-0.6-0.7-0.1-0.7-0.1-0.5 0.2-0.4 0.3-0.6 0.8-0.3 0.5-0.2 0.4-0.2 0.8-0.2 0.2-0.2 0.1-0.4-0.1-0.8-0.6-0.4 0.1-0.5 0.4-0.8 1-0.1 0.5 0 0.4 0.1 0.5-0.2 0.6-0.2 0.3-0.3 0.1-0.5-0.2-0.6-0.3-0.7-0.6-0.4-0.4-0.3-0.4-0.2 0-0.3 0.2-0.5 1.2-0.2 0.8-0.7 0.7-2 1.1-5.2-1.2-1.5 0.2-1.4 1.2-1 0.5-0.3 0-0.4-0.2-0.8-0.7-0.4-0.2-0.3 0-0.4 0.2-0.2 0.3-0.2 0.3-0.1 0.8-0.2 0.6-0.2 0.3-0.4 0.4-0.2 0.1-2 0.1-5.4-0.8-0.9 0.1-1 0.4-0.3 0.2-0.8 0.7-0.2 0-0.3 0-0.1-0.5 0-0.7 0-0.4-0.2-0.3-0.5-0.6-0.1-0.5 0-0.4 0.1-1.1 0.2-0.3 0.2-0.1 1.2-0.2 0.5-0.2 0.4-0.5 0.2-0.7 0-0.7-0.1-0.6-0.2-0.5-0.4-0.4-2-1.5-0.3-0.5-0.2-0.4 0-0.3-0.2-0.3-0.5-0.5-0.2-0.3 0-0.3-0.1-0.6 0-0.3-0.3-0.5-0.3-0.2-0.4-0.1-0.6 0.2-0.3 0.2-0.4 0.6-0.2 0.1-0.3-0.1-0.4-0.4-0.3-0.4-0.1-0.3-0.1-0.6-0.2-2.3 0.1-0.6 0.2-0.7 0.5-0.9 0.1-0.6-0.1-0.6-0.2-0.5-0.3-0.5-0.4-0.4-2.4-2.1-1.9-2.2-1.3-1.8-0.2-0.6-0.2-0.5 0-0.6-0.1-4.4 0.2-1.8 0-0.3-0.2-0.2-0.2-0.2-1.8-0.7-0.4 0-0.2 0.3-0.1 0.4-0.3 0.3-0.5 0.3-1.2 0.2-0.5 0.3-0.3 0.3-0.4 0.1-1.2 0.3-0.2 0.2-0.1 0.3 0.1 0.6 0 0.4-0.2 0.2-0.3 0.1-1.2-0.3-0.4-0.1-0.5 0.2-0.3 0.3-0.2 0.2-0.1 0.3 0.1 0.9-0.1 0.3-0.3 0.1-0.4 0-4.2-1.9-1.8-1.5-0.8-0.8-0.6-1-0.3-0.1-0.5-0.1-1.9 0.5-2.9 1.3-0.3 0.4-0.1 0.3 0.1 0.4 1.7 1 0.2 0.2 0 0.3-0.5 0.3-0.8 0.3-2.3 0.1-1.6 0.4-0.5 0.7-0.4 0-0.6-0.1-4.2-1.9-16.8-3.5-0.4-0.2-1.5-0.7-0.5-0.3-0.5-0.4-0.2-0.2-0.5-0.1-0.8 0.1-1.5 0.5-0.6 0.4-0.4 0.4-0.1 0.3-0.3 0.6-0.2 0.2-0.6 0.1-5.3-0.7-2.1-1.2 1.9-5.5 0.5-2 0.1-1.1 0-0.4-0.2-1-0.1-0.6 0.1-0.3 0.2-0.3 0.6-0.3 0.4-0.4 0.1-0.4 0.1-0.3 0-1.4 0.2-0.9 0.2-0.6 0.3-0.4 0.4-0.3 0.5-0.4 0.4-0.6 0.2-0.6 0-0.6-0.2-0.4-0.3-0.2-0.4-0.2-0.7-0.6-0.6-0.6-0.1-0.4-0.2-0.7 0-0.9 0-1.6 0.1-0.9 1.3-5 0.2-0.5 0.2-0.3 0.2-0.1 0.3-0.2 0.4-0.2 0.5-0.8 0.1-0.6 0.1-0.5 0-1 0-2 0.2-0.9-0.1-0.5-0.2-0.3-1.1-1-0.2-0.3-0.2-0.4 0-0.7 0.1-0.2 0.9-1 0.6-0.6 0.8-1.6 0.5-1-0.1-0.4-0.2-0.2-0.6-0.3-0.7-0.2-0.3 0-0.2 0.2-1.1 2.5-0.2 0.2-0.2 0.2-0.3 0-2.9-0.6-1.2-0.6-0.5-0.4-0.5-0.5-0.1-0.3 0.1-0.3 0.8-0.5 0.3-0.4 0.1-0.4-0.1-0.3-0.1-0.2-0.2-0.2-0.3 0-1.1 0.3-0.7-0.1-2.1-1-2.1-0.6-0.3-0.3 0.1-0.5 1.3-2.3 0.1-0.3 0.2-0.6-0.1-0.4-0.1-0.5-0.3-0.8 0-1.2 0.1-0.5 0.2-0.3 2.9-1 0.5-0.5 0.1-0.6 0.1-0.6-0.1-1 0-0.5 0.2-0.4 1.5-0.8 0.3-0.2 0.2-0.4 0.1-0.3-0.1-0.3-0.3-1.1 0-0.5 0.2-0.3 0.3-0.7 0-0.2-0.2-0.3-0.7-0.6-0.2-0.3-0.2-0.5 0-0.5 0.1-0.5 0.2-0.8 0-0.4 0-0.4-0.2-0.2-0.2-0.2-0.2-0.1-0.7 0-0.3 0-0.2-0.2-0.2-0.4-0.2-0.6-0.5-1-0.3-0.5 0-0.5 0-0.3 0.4-0.8 0.4-0.5 0.3-0.1 0.6 0.2 0.2 0.2 0.2 0.3 0.4 1.1 0.3 0.5 0.2 0.2 0.7 0.2 0.7 0 1.1-0.2 0.6-0.3 0.2-0.2 0.2-0.3 0.2-0.5 0-1-0.1-0.7 0-0.5 0.1-0.4 0.4-0.6 0-0.4-0.4-0.4-0.3-0.4-0.2-0.6-0.3-1.4 0.1-0.7 0.1-0.4 0.5-0.3 0.3-0.2 0.3-0.5 0-0.4 0-0.4-0.5-1.8 0-0.2 0.2 0 1.7 0.9 0.5 0.2 0.2-0.1 0.8-1.1 0.2-0.4-0.2-0.5-0.2-0.2-1.1-1-0.3-0.4-0.1-0.6-0.1-1.1 0.1-0.5 0.3-0.3 0.9-0.3 0.3-0.2 0.1-0.3 0-0.2-0.2-0.3-0.4-0.4-0.3-0.2-0.2-0.4-0.2-3.6-0.1-0.4-0.2-0.3-0.3-0.1-0.3-0.2-0.2-0.3-0.2-0.8 0.1-1 0.9-1.2 0-1-3-3-0.4-0.6-0.4-0.7-0.6-2-0.3-0.7-0.3-1.9 0.5-2.1 1-1.7 0.7-0.7 1.1-0.7 0.5-1.4 0.6-1.2 1.4-0.4 0-0.7-0.4-1.1 1.1-0.3 2.5 0.2 0.6-0.4 2-1.7 0.8-0.3 1.3-0.2 3.1-1.1 0.6-1-0.1-1-0.9-1.5-0.3-0.2 0-0.2 0.7-0.8 0.3-0.5 0.1-0.5 0-0.4 0-2.9 0.1-0.6 0.2-0.3 0.3-0.1 0.2 0 0.3 0.4 0.3 0.6 0.2 0.1 0.3 0.1 0.7 0.1 1.3-0.2 1.2-0.3 0.3-0.2 0.3-0.4 0.4-1 0.1-0.5 0.1-0.5 0-0.4-0.1-0.2-0.3-0.1-1.7-0.2-0.3-0.1-0.2-0.2-0.3-0.8-0.2-0.2-0.6-0.7-1-1.4-1.3-1.3-0.3-0.2-2.4-0.8-0.2-0.2-0.2-0.8-0.3-4.3 0.3-1.1 1.5-1.5 0.5-0.9 0.2-0.6 0.1-1.7 0.1-0.7 0.3-0.6 0.7-1.1 0.1-0.6 0.2-1.4 0.4-1.5 0.2-0.5 0.2 0.2 0.8 0.4 1-0.1 0.8-0.6 0.8-0.6 0.8-0.5 1.1 1.5-0.4 3.5 1 1.4 1.2-0.1 3.8-3.8 4.8-2.3 2.5-0.5 2.6 0.1 1.3 0.5 0.7 0.9 0.4 1.2 0.9 1.6 1.1 1.1 2.3 1.4 1 1.5 1.4 3.1 0.8 1 3.5 2.4 0.9 1 1 1.5 0.5 1 0.3 0.9 0.2 0.9 0 1-1 2 0 0.5 0.2 1.5 0.2 3 0.3 1.7 0.6 1.6 1.2 1.9 0.2 1.6-0.5 1.3 0 0.8 2.8 0 1.1 0.8 2.1 2.7 0.3 0.3 0.7 0.5 0.3 0.4 0.2 0.4 0.2 1.2 0 0.3 0.6 0.2 1.1-0.5 0.6 0 1 0.7 1.8 2.1 1.1 0.7 2.1 2.2 1.5 3.6 0.1 3.7-2.1 2.5-1 0.2-3.3 0-9.4 2.3-2.2 1.2-0.5 2.1 0.6 0.9 2 0.6 0.7 0.5 0.3 0.6 0.2 1.9 0.3 0.8 0.4 0.7 2.3 2.7 0.7 1.2 0.5 1.6 0.1 1.9-0.1 0.9-0.5 1.6-0.2 0.9 0.1 0.9 0.2 0.8 0.2 0.7-0.2 0.9-0.4 0.5-1.3 0.3-0.2 0.5 0.2 0.8 0.5 0.7 1.1 1.1 0.9 0.5 0.9 0.4 0.9 0.2 0.9 0 1.1-0.6 0.5 3-1.4 2.9-2.2 2.4-3.2 2.2 4 1.8 1.3 0.2 1-0.4 2-1.8 2-0.5 2 0.4 4 1.4 1.8 0.7 3.6-0.7 1.7 0.3 0.8 0.8 0.3 1.2 0.2 1.4 0.6 1.3 0.8 0.8 1 0.3 4 0.3 3.9-0.9 4.5-1.9 2.6-1.6 0.8-0.1 1.1 0.5 1 0.7 1.1-0.2 1.1-0.5 1.1-0.2 1.1 0.4 0.7 0.9 0.4 1.3 1.4 8.1 1.1 2.9 1.6 1.5 1.9 0.2 3.7-0.9 1.9 0.1 1.1 0.4 1 0.6 0.5 1-0.4 1.5-0.8 0.7-3 0.7 0.2 0.9 0 2.4 0.1 0.9 0.5 0.6 1.3 0.3 0.7 0.5 0.7 1.1 0 1-0.1 1.1 0.1 1.4 0.4 1.1 1.1 1.5 0.5 1.1 0.1 1.3-0.2 1 0.1 0.9 0.8 0.8 1.4 0.8 0.6 0.6 0.5 0.9 0.5 2.4-0.2 2.2 0.1 1.9 1.3 1.7-4.3 1.9-0.8 1.1 0.1 1.1 2.6 10.5 1.2 2.2 1.8 0.8 1.2 0.2 1.2 0.4 1 0.8 0.8 1.5 0.4 2 0.1 1.6 0.3 1.3 1.2 1.4 1.1 0.8 1.1 0.6 1.2 0.3 1.1 0.1 1.1-0.4z"
-        id="17" name="Sumy" fill="{{ if (index .alerts 17) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="17" name="Sumy" fill="{{ if (index .alerts "17") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M712.5 281.8l1.9 0.4 0.7-0.2 0.2-0.1 0.3 0 0.4 0.1 0.5 0.4 0.4 0.2 0.6 0.1 1.2-0.3 0.4 0 1.4 0.3 1 0 0.3 0.1 1 0.4 1.6 1.2 0.4 0.4 0.3 0.4 0.2 0.8 0.2 0.3 3.6 2.9 0.3 0.5 0.2 0.4 0.3 0.3 1.5 1 0.2 0.3 1 1.3 0.8 0.8 3.2 1.9 0.9 0.7 1.1 1.3 0.4 0.1 0.4 0.1 0.7 0 1.1-0.3 0.3 0 2.8 0.5 0.3-0.1 0.5-0.2 0.3-0.1 0.4 0.1 0.9 0.8 0.3 0.3 0.3 0.2 0.3 0.1 1.7-0.1 0.4 0.1 0.4 0.2 0.5 0.5 0.4 0.2 0.5 0 0.3-0.2 0.5-0.3 0.4 0 2.3 0.7 1.2 0.5 0.5 0.4 0.6 0 0.4 0 0.5-0.4 0.5-0.2 0.3 0 0.5-0.2 0.2-0.1 0.9-0.7 1-0.5 2.6-1 0.7-0.4 0.5 0.1 0.7 0.5 1.5 1.4 0.5 0.7 0.2 0.6 0 0.7 0 0.3 0.4 0.5 1 1.2 0.2 0.4-0.2 0.5-0.6 1.1-0.4 0.4-1.4 0.8-0.6 0.6-0.1 0.2 0 0.2 0.3 0.2 1.8-0.1 0.3 0 0.4 0.3 0.9 0.8 0.5 0.3 1.4 0.4 0.5 0.3 0.2 0.4 0 0.4 0.2 0.4 0.5 0.4 1.2 0.7 0.5 0.4 0.4 0.4 0.3 0.7 0.3 0.3 1.5 1.1 0.3 0.4 0.1 0.4-0.3 0.4-0.3 0.3-1.1 0.8-0.2 0.2-0.2 0.6-0.1 0.3 0.1 0.3 0.2 0.2 2.1 1.5 1.4 1.4 3.9 3.3 0.7 0.8 0.2 0.5-0.2 0.6-0.7 1.4-0.2 0.5 0 0.3 0.2 0.2 0.9 0.7 0.9 1 0.2 0.4-0.2 0.5-1.5 1.7-0.2 0.5 0.1 0.2 0.6 0.6 0.2 0.1 0.3 0 0.5-0.4 0.5-0.5 0.2-0.2 0.2-0.1 0.6 0.3 0.4 0.4 0.3 0.2 0.4 0 0.4-0.2 1-0.7 0.1-0.3 0-0.3-0.5-0.4-0.1-0.1-0.1-0.3 0.1-0.3 0.9-1.5 4-4.9 0.9-0.6 0.3-0.1 0.5 0 0.9 0.3 2.4 1.4 0.9 0.7 0.3 0.4 0.3 0.5 0.2 0.2 0.4 0.2 0.7 0.1 2-0.2 0.5-0.2 0.4-0.5 1.1-0.7 3.2-0.4 0.2 0.7 0.1 0.7 0.2 0.4 0.2 0.2 0.3 0 1.9-0.3 0.3 0 0.2 0.1 0.2 0.2-0.1 0.5-0.2 0.3-0.5 0.3-0.2 0.2-0.6 1.2-0.5 0.4-0.2 0.2-0.1 0.5 0 1.6 0 0.5 0.1 0.2 0.1 0.1 0.2 0.1 1.8-0.2 0.2-0.1 0.2-0.2 0.3-0.6 0.4-1.9 0.1-0.4 0.3-0.5 0.3-0.3 0.3-0.1 2.4-0.2 0.6 0.1 0.2 0.2 0.1 0.2 0.2 0.5 0.1 0.9 0.1 1.8-0.1 0.4-0.1 0.3-0.4 0.3-0.6 0.3-0.7 0.3-0.2 0.2-0.1 0.3 0.2 0.8 0.1 0.7 0 0.2-0.1 0.3-0.2 0.2-0.6 0.3-0.2 0.2-0.1 0.4-0.1 2.5 0.2 0.4 0.5 0.4 0 0.2-0.2 0.4-0.3 0.2-1.3 0.6-0.2 0.2-0.1 0.3 0 0.7 0.1 0.4 0.2 1.2 0.3 2.4-0.1 0.4-0.3 1.8 0 0.4 0.1 0.6 0.1 0.2 0.2 0.2 0.3 0 0.7-0.3 0.6-0.1 0.5 0.1 0.3 0.1 0.1 0.2 0.1 0.5 0.2 0.2 0.2 0.1 1.2 0.2 0.3 0.2 0.2 0.2 0.1 0.2 0 0.9 0.1 0.6 0.3 1.1 0.5 3.2 0.1 0.8 0.1 0.9 0 0.3-0.1 0.4-0.3 0.5-0.8 0.8-0.4 0.3-0.4 0.2-0.6 0.1-0.2 0.2-0.2 0.3-0.1 0.5 0 0.8 0.4 2.2 0.1 0.6 0.6 1.5 0.1 0.6 0.1 1.1 0 0.7-0.1 0.3-0.3 0.5-0.6 0.6-1.4 1.2-0.6 0.4-0.6 0.2-0.3-0.1-1.9-1-2.1-0.6-0.5-0.3-0.2-0.2-0.2-0.8-0.4-0.4-0.2-0.2-3.3-1-0.6 0-1.8 0.5-1 0-0.3 0-0.5 0.2-0.4 0.5-0.2 0.5-0.1 0.8-0.3 4.8 0.1 0.4 0.2 1.1 0 0.9 0.3 0.5 0.3 0.2 1 0.2 0.3 0.1 0.2 0.1 0.1 0.3 0.6 1.5 0.4 0.7 0 0.5-0.3 0.1-0.6 0.1-1.8 0.1-0.4 0-0.3 0.2-0.4 0.3-0.4 0.1-0.9 0-0.2 0.2-0.2 0.2-0.1 0.5-0.1 0.3 0 0.7 0.1 0.3 0.2 0.3 0.5 0.3 0.8 0.2 0.3 0.3 0.2 0.3 0.4 1.9-10.2 1.5-0.2-0.1-0.2-0.2-0.3-0.4-0.4-0.4-0.6-0.1-0.3 0.1-0.5 0.2-0.6 0.5-0.2 0.4-0.4 0.6-0.4 0.3-0.5 0.1-1.2 0-0.7-0.1-0.4-0.1-0.6-0.6-0.2-0.1-0.3-0.2-1.3-0.1-0.5-0.2-0.1-0.2-0.2-1.8-0.1-0.5-0.2-0.2-0.6 0-0.3 0.1-0.4 0.1-0.4 0.5-0.2 0.4-0.3 0.6-0.2 0.2-0.5 0.2-2.5 0.2-0.4 0-0.4-0.4-0.2-0.2-0.5-0.2-0.1-0.2-0.1-0.6-0.2-0.2-0.5-0.3-0.1-0.2-0.1-0.6 0.1-0.3 0.1-0.1 0.5 0 0.2 0.2 0.4 0.4 0.3 0.1 0.3-0.1 0.3-0.4 0.1-0.4 0.5-1.4 0.4-0.9 0-0.3 0-0.3-0.2-0.8 0.1-0.6 0.1-0.5 0-0.3-0.1 0-1.6 0.1-0.4-0.3-0.2-0.3-0.2-0.2-0.7-0.3-0.1-0.2 0.1-0.9 0-0.4-0.1-1.1-0.1-0.6-0.3-0.5-0.2-0.1-0.3-0.1-0.8-0.2-0.3-0.3 0-0.2 0.1-0.3 0.3-0.5 0.7-0.8 0.3-0.6 0.1-0.3-0.2-0.5-0.3-0.1-0.4 0.1-1.8 1.3-0.5 0.1-0.6 0-0.3-0.2-0.1-0.2 0-0.7 0-0.3-0.3-0.7-0.1-0.6 0.1-0.4 0.2-1.1 0-0.3 0-0.3-0.2-0.2-0.2-0.2-0.2-0.1-0.4-0.1-0.6 0-3.3 0.8-3 1.2-0.6 0.1-0.5-0.1-0.8-1.3-0.4-0.4-0.3-0.1-2.7-0.6-0.5-0.3-0.7-0.8-0.4-0.7-0.4-0.4-0.6-0.2-0.6-0.1-1.6 0.2-2.3 0.6-0.5 0.3-0.9 0.7-1.2 1.3-0.6 0.4-0.9 0.3-0.5 0.1-0.5 0-0.8-0.5-8.7-1.5-3.6-1.8-1.2-0.4-0.5 0-3 0.5-0.9 0.3-0.5 0.1-0.5 0-1.5-0.7-0.6-0.1-0.9 0-1.2 0.3-0.3 0.2-0.5 0.3-1 0.1-0.5 0-0.6-0.1-0.6 0.2-0.8 0.5-1.9 1.6-1.4 0.9-3.2 0-0.3-0.1-0.2-0.2-0.2-0.5-0.1-0.6 0-0.6 0-1 0-0.2-0.2-0.2-0.3-0.1-0.6 0-0.3 0.2-0.3 0.2-0.2 0.8-0.1 0.4 0.1 0.4 0.2 0.8 0.3 0.7 0.1 0.3-0.2 0.5-0.1 0.5-0.1 2-0.1 0.2-0.2 0.3-0.7 0.5-0.3 0.2-0.2 0.5 0 0.4 0.1 0.3 0.2 0.1 0.2 0.1 0.6 0 0.3 0.1 0.2 0.1 0.2 0.5 0 0.7 0 0.3 0.3 0.5 0.3 0.4 0.6 0.3 2 0.5 0.3 0.1 0.3 0.5 0.1 0.5 0.1 0.3-0.1 0.7-0.2 0.4-0.3 0.3-0.6 0.3-2.4 0.5-0.3 0.2-1.5 1.3-0.3 0.4-0.2 0.6-0.2 0.8 0 0.6 0.2 0.5 0.4 0.4 4.3 2.6 0.3 0.4 0.1 0.3 0 0.5-0.2 0.4-0.5 0.9-0.2 0.5 0 0.4 0 0.3 0.2 0.8 0 0.3-0.2 1.1 0 0.3 0.1 0.2 0.3 0.2 1 0.2 1.3 0.2 0.3 0.2 0.2 0.1 0 0.6-0.6 3.9-0.4 1.3-0.4 2.4-0.4 1.4-0.8 2.1-0.1 0.6 0 0.2 0.2 0.2 1.1 0.9 0.3 0.4 0.3 0.5 0 0.6-0.4 1.7-0.1 0.3-0.3 0.6-0.5 0.6-0.5 0.1-3.6-0.2-5.7-1.1-4.7-2-2.8-0.5-0.6 0.2-7.6 3.6-7 1.5-6.4 2.1-2.6 0.4-5.5-0.5-8.6-2.8-1.3 0-7 1.9-5.4 0.4-0.7-0.2-0.1-0.2-0.3-0.9-0.3-0.5-0.3-0.3-0.4 0-1.3 0.1-0.3-0.1-0.2-0.2-0.3-1.1-0.2-0.5 0-0.3 0.2-0.6 0.5-1 0-0.2-0.1-0.4-0.2-0.3-0.4-0.4-0.4-0.1-0.3-0.1-3.3 0.7-0.5 0.2-0.1 0.2-0.1 0.3 0.2 0.8 0 0.6-0.1 0.3-0.4 0.4-0.5 0.2-5.9 1.2-0.4 0.2-0.3 0.5-0.1 0.5-0.2 0.2-0.3 0.3-0.4 0-0.3-0.1-0.6-0.3-0.5-0.5-0.4-0.4-0.1-0.2-0.2-0.5-0.2-0.8-0.2-0.5-0.6-0.3-0.9-0.4-4.1-0.8-0.4-0.4-0.8-0.7-0.3-0.1-4.2-1.2-4.7-0.7-0.4-0.2-0.2-0.2-0.1-0.2 0-0.4 0.1-0.6 0.4-0.6 0.6-0.7 0.3-0.4 0.2-0.4 0-0.5-0.1-0.8-0.3-0.9-0.4-1-0.9-1.6-0.3-0.8-0.1-0.5 0.3-0.2 0.4-0.4 0.3-0.1 2.1-0.6 2.4-0.2 0.2-0.3 0.1-0.4-0.3-1.1-0.3-0.5-0.3-0.3-0.4-0.3-0.2-0.4 0-0.6 0-4.5 0.2-1.1 0.2-0.5 0.1-0.4 0.1-1.9 0.1-0.4 0.1-0.3 0.4-0.5 0-0.3-0.2-0.5-0.5-0.8-0.4-0.2-0.4-0.1-0.3 0.1-0.6 0-0.3-0.2-0.1-0.3 0-0.5 0.4-1.5 0.3-0.8 0.1-0.5-0.6-0.5 0.6-2.8 0.6-2.2 0.2-0.2 0.4-0.3 0.5-0.1 0.6-0.4 0.4-0.6 0.5-1 0.4-0.5 0.5-0.5 0.8-0.6 0.4-0.3 0.4-0.1 2.1 0.2 1.3-0.4 0.4-0.2 0.2-0.3 0.3-1 0.1-0.7-0.1-0.6 0-0.6-0.1-0.6 0.2-0.7 0.2-1 0.3-1.5 0.2-0.2 0.3-0.1 0.2 0 0.2 0.4 0.4 2.3 0 0.2-0.3 0.7-0.1 0.3 0 0.6 0.2 0.8 0.1 0.3 0.2 0.2 0.3 0.2 0.2-0.2 0.2-0.2 0.4-1.3 0.5-1.2 0.1-0.3 2.3-2.5 0.3-0.5 0.2-0.5 0.2-0.2 0.3 0 0.2 0.1 0.5 0.6 0.4 0.4 0.6 0 0.7-0.1 0.3-0.1 0.3-0.3 0.5-0.6 2.2-2.1 0.2-0.2 0.6-1.2 0.3-0.2 0.3-0.1 0.4 0 0.4-0.1 0.7-0.2 0.3-0.3 0.1-0.4 0.6-3.9-0.1-0.6-0.1-0.2-0.2-0.2-0.7-0.2-0.2-0.2-0.2-0.4-0.1-0.7-0.1-4.3-0.4-1.7 0-0.7-0.1-0.5-0.5-0.3-0.2-0.1-0.1-0.3-0.1-0.7 0.1-0.5 0.2-0.3 0.4-0.3 0.1-0.3 0-0.3-1.2-2.8-0.2-0.5 0-0.4 0.1-0.6 0-0.2-0.2-0.1-0.5 0-0.2-0.1-0.1-0.4-0.1-0.7 0-0.7 0.2-0.3 0.2-0.3 1.6-1 4.7-1.9 0.2 0.1 0.1 0.4 0.3 0 0.4-0.2 1.5-1.6 0.5-0.3 5.8-2.9 0.5-0.3 2-2.1 0.3-0.2 0.6-0.1 0.4-0.1 0.5-0.2 0.7-0.6 0.4-0.4 0.1-0.3-0.1-0.2-0.3-0.2-2.2-0.5-0.3-0.1-0.1-0.2-0.1-0.6-0.3-0.5-0.2-0.2-3.3-0.2-0.6-0.1-1.2-0.9-0.3-0.1-3.1-0.6-0.2-0.1-0.4-0.4-0.3-0.8-0.2-0.5-0.2-0.2-0.5-0.3-0.2-0.2-0.3-0.4-0.3-0.9 0.1-0.5 0.8-1.3 0.4-0.4 0.3-0.2 0.3 0 0.2 0 0.2 0.2 0.3 0.5 0.2 0.2 0.4 0 0.3 0 2.2-1.9 0.3-0.1 0.4 0 0.6 0.2 0.2 0.2 0.2 0.2 0.2 0.9 0.1 0.2 0.2 0.2 0.3 0.2 0.3 0 0.3-0.1 0.4-0.2 1-1.4 0.5-0.4 0.3 0 0.7 0.1 0.2 0.2 0.2 0.2 0 0.3-0.2 1.1 0.1 0.3 0.1 0.2 0.4-0.1 0.3-0.4 0.4-0.6 0.2-0.3 0.2 0 0.1 0.2 0.1 1 0 0.2 0.3 0.6 0 0.8 0.5 0.3 0.3-0.1 0.3-0.2 0.3-0.5 0.4-1.4 0.4-0.7 0.5-0.3 0.3-0.4 0.4-0.7 0.5-0.4 0.2-0.4 0.1-0.3 0.1-0.4 0.3-1 1.4-2.2 0.5 0.5 6.2 4.4 0.4 0.1 0.8-0.1 0.4 0 0.3 0.2 0.7 0.6 0.3 0.3 1 0.4 2.9 0.5 1.7 0.9 1.7 2.2 0.7 0.1 1.6-1.1 1.2-1.2 0.4-0.4 0.1-0.3-0.1-0.2-0.1-0.3-0.7-0.5-0.2-0.2-0.1-0.3 0-0.6 0.1-0.3 0.1-0.3 1.1-1.2 0.6-0.4 0.3-0.3 0.1-0.3-0.2-1.8-0.2-0.5-0.2-0.2-1.6-1.6-0.2-0.5 0-0.6 0.1-1.1 0.2-0.7 0.5-1.1 0.8-2.4 0.4-0.6 0.3-0.3 0.6-0.5 1-0.4 0.7 0 0.4-0.2 0.3-0.3 0-0.3-0.1-0.2-0.2-0.1-0.6 0-0.4-0.1-0.1-0.5 0.2-0.5 0.2-0.2 1.4-1.2 0.8-0.8 0.7-1 0.1-0.3 0.1-0.7-0.1-0.6 0-0.3 0.2-0.4 0.3-0.1 0.3 0 0.6 0.3 10-4.4 1.9-0.3 4.5-1.4 0.5-0.2 0.4-0.3 0.5-0.7 0.6-0.6 0.4-0.3 0.5-0.2 0.3-0.1 1.4 0.1 0.4-0.1 0.5-0.4 0.3-0.1 0.3 0 1.6 0.6 0.2 0.2 0.1 0.2 0 0.3-0.1 0.2-0.4 0.4-0.2 0.2-0.1 0.3 0.1 0.2 0.2 0.3 0.3 0.1 0.7 0.1 0.3-0.2 0.3-0.1 0.3-0.6 0.3-1.1 0.2-0.5 0.5-0.5 0.3-0.2 0.4-0.1 0.3 0 0.6 0.2 0.6 0.3 1.7 1.1z"
-        id="3" name="Dnipropetrovs'k" fill="{{ if (index .alerts 3) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="3" name="Dnipropetrovs'k" fill="{{ if (index .alerts "3") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M936.8 391.1l-8.8-0.3-2.6 1-1.9 2.4-1.4 3.2-1 3.8-0.4 3.4-0.6 1.3-1.2 0.1-1.9-0.7-0.9 0.1-1 0.8-2.1 2.4-1 0.9-1.4 0.6-11.6 1.6-1.9 1.3-1.4 2.3-1.1 2.9-0.7 2.9 0.1 5.8-0.3 2.3-1.3 2.5-1.7 2.3-0.7 1.5-0.1 1.3 0.7 0.8 1 0.2 2.1-0.4 1 0.1 0.9 0.4 0.5 0.9-0.2 1.6-0.7 1.2-1.1 0.8-1.3 0.6-1 0.8-1.1 1.8-0.4 2.5 0.3 2.5 0.8 2.3-1.1 1.1-3 4.2-1.9 0.9 1.3-2.6-0.1-1.3-1.4-0.6-1.8-1.4-0.3 0.1-0.3 0.3-1.4 0.8-0.5 0.2-2.1-0.8-1.5-0.3-0.6 0.4-4.9-0.9-6 2.2-2.4 0-7.2-1.3-1.9 0.7-3.5 3.8-5.8 8.2-1.4 2.9-1 1.3-1.2 0.6 0.3-2.1-1.3-1.6-1.9-1-1.8-0.3-1.1 0.3-2.5 2-6.4 2.5-0.5 0.1-1.6-3.1-0.1-0.6 0.1-0.4 0.3-0.5 0.2-0.2 1.6-1.5 0.2-0.2 0.6-0.4 0.3 0 0.8 0.4 0.3 0.1 0.2 0 0.5-0.2 0.8-0.6 0.1-0.4-0.1-0.5-1.1-1.9 0.2-0.5 0.4-0.4 0.6-0.6 0.2-0.2 0.1-0.3-0.2-0.3-0.4-0.5-0.2-0.2-0.9-0.6-0.9-1-0.4-0.1-0.4 0.3-0.4 0.7-0.2 0-0.2-0.1-1.9-1.6-0.3-0.3-0.2-0.5-0.6-2.5-0.5-0.9-0.8-1.4-0.3-0.4-0.2-0.2-1-0.6-2.4-1-0.5-0.3-1.3-1.4-0.3-0.4-0.2-0.5 0.2-0.3 0.3-0.1 0.3-0.1 2.9-0.2 0.5-0.2 0.4-0.3 0.1-0.6-0.2-2.1-0.1-0.5-0.1-0.5-0.8-1.7-0.3-0.7 0-0.9 0.1-0.3 0.2-0.3 0.5-0.2 0.5-0.1 0.5 0.5 0.3 0 0.6 0.1 0.2 0 0.6 0.6 0.7 0.2 1 0.1 1.2 0 2-0.7 0.2-0.2 0.2-0.2 0.1-0.3 0-0.3 0-0.9 0-0.3 0.2-0.6 0.5-0.8 0.3-0.6 0.2-0.7 0.2-0.6 0.4-0.4 0.4-0.3 0.2-0.1 0.5 0 0.5 0.2 0.2 0.1 0.6 0.6 0.4 0.7 0.2 0.5 0.1 0.1 0.5-0.1 0.4-0.3 0.3-0.4 0.1-0.3-0.2-0.7 0-0.7 0-0.6 0.1-0.3 0.2-0.7 0.6-1.1 0-0.3 0-0.7 0.2-0.6 0.8-1.6 0.2-0.3 0-0.3-0.1-0.2-0.4-0.1-1 0-2.3-0.4-0.3 0.1-0.2 0.2-0.1 0.5-0.4 0.3-0.3 0-0.3-0.3-1.1-1.7-0.2-0.2-4.3-3.8-1.5-1.9-0.4-0.4-2.4-1.5-0.4-0.1-0.4 0-1.4 1.2-0.4 0.3-0.2 0.2-0.7-0.4-0.9-0.9-2.2-2.4-0.9-0.8-0.6-0.4-1.7 0.7-0.6 0.1-0.2-0.1-0.2-0.3-0.1-0.5 0.1-0.5 0.2-1 0-0.3-0.2-0.2-1-0.2-0.2-0.2-0.2-0.3-0.2-0.4 0-0.7 0.1-0.3 0.3-0.1 0.5-0.2 0.5-0.2 0.2-0.2 0-0.2-0.1-0.2-0.4-0.2-1.4-0.3-0.3-0.3-0.4-0.5-0.7-2.1-1.6-3.4-0.1-0.5-0.1-1.2-0.1-0.6-0.1-0.2-0.2-0.1-0.4 0-1.4 0.2-0.6 0-0.7-0.1-0.6-0.3-0.3-0.9-0.1-3.4-0.4-1.9-0.2-0.3-0.3-0.3-0.8-0.2-0.5-0.3-0.2-0.3-0.1-0.3 0-0.7 0.1-0.3 0.1-0.5 0.2-0.2 0.2-0.2 0.9 0 0.4-0.1 0.4-0.3 0.3-0.2 0.4 0 1.8-0.1 0.6-0.1 0.3-0.1 0-0.5-0.4-0.7-0.6-1.5-0.1-0.3-0.2-0.1-0.3-0.1-1-0.2-0.3-0.2-0.3-0.5 0-0.9-0.2-1.1-0.1-0.4 0.3-4.8 0.1-0.8 0.2-0.5 0.4-0.5 0.5-0.2 0.3 0 1 0 1.8-0.5 0.6 0 3.3 1 0.2 0.2 0.4 0.4 0.2 0.8 0.2 0.2 0.5 0.3 2.1 0.6 1.9 1 0.3 0.1 0.6-0.2 0.6-0.4 1.4-1.2 0.6-0.6 0.3-0.5 0.1-0.3 0-0.7-0.1-1.1-0.1-0.6-0.6-1.5-0.1-0.6-0.4-2.2 0-0.8 0.1-0.5 0.2-0.3 0.2-0.2 0.6-0.1 0.4-0.2 0.4-0.3 0.8-0.8 0.3-0.5 0.1-0.4 0-0.3-0.1-0.9-0.1-0.8-0.5-3.2-0.3-1.1-0.1-0.6 0-0.9-0.1-0.2-0.2-0.2-0.3-0.2-1.2-0.2-0.2-0.1-0.2-0.2-0.1-0.5-0.1-0.2-0.3-0.1-0.5-0.1-0.6 0.1-0.7 0.3-0.3 0-0.2-0.2-0.1-0.2-0.1-0.6 0-0.4 0.3-1.8 0.1-0.4-0.3-2.4-0.2-1.2-0.1-0.4 0-0.7 0.1-0.3 0.2-0.2 1.3-0.6 0.3-0.2 0.2-0.4 0-0.2-0.5-0.4-0.2-0.4 0.1-2.5 0.1-0.4 0.2-0.2 0.6-0.3 0.2-0.2 0.1-0.3 0-0.2-0.1-0.7-0.2-0.8 0.1-0.3 0.2-0.2 0.7-0.3 0.6-0.3 0.4-0.3 0.1-0.3 0.1-0.4-0.1-1.8-0.1-0.9-0.2-0.5-0.1-0.2-0.2-0.2-0.6-0.1-2.4 0.2-0.3 0.1-0.3 0.3-0.3 0.5-0.1 0.4-0.4 1.9-0.3 0.6-0.2 0.2-0.2 0.1-1.8 0.2-0.2-0.1-0.1-0.1-0.1-0.2 0-0.5 0-1.6 0.1-0.5 0.2-0.2 0.5-0.4 0.6-1.2 0.2-0.2 0.5-0.3 0.2-0.3 0.1-0.5-0.2-0.2-0.2-0.1-0.3 0-1.9 0.3-0.3 0-0.2-0.2-0.2-0.4-0.1-0.7-0.2-0.7-0.8-2-0.2-1 0.2-2.3-0.1-1.5 0-0.7-0.9-3.7-0.2-0.9 0-0.7 0.2-0.3 0.2-0.4 0.3-0.2 0.3-0.1 0.6 0 0.7 0.2 1.6 0.9 0.7 0.2 2.6 0.2 0.3-0.1 0.6-0.6 0.6-0.6 0.8-0.7 0.2-0.1 0.6-0.2 0.5-0.1 0.3 0 0.7 0.1 5.3 2.7 0.6 0.5 0.3 0.1 0.5 0.1 0.3-0.2 0.2-0.2 0-0.4 0-0.3-0.1-0.2-0.2-0.2-1.2-0.5-0.3-0.3-0.1-0.2 0-0.5 0.1-0.3 0.7-1 0.3-0.3 0.3-0.1 0.3 0 0.6 0.3 0.4 0.1 0.6 0 0.3-0.1 0.2-0.3 0.2-1.2 0.2-0.6 0.2-0.3 0.3-0.2 0.6 0 0.3 0 0.3 0.1 0.2 0.2 0.5 0.7 0.4 0.4 0.2 0.2 0.6 0.1 0.5 0 1.2-0.3 0.4-0.2 0.2-0.3 0-0.3-0.2-1.5 0.1-0.3 0.1-0.4 0.3-0.5 0.3-0.2 0.4-0.1 0.3-0.2 0.1-0.3 0.3-0.7 0.3-0.8 0.2-0.3 2.1-1.5 0.4-0.2 1.5 0 0.7-0.2 0.3-0.2 0.2-0.3 0-0.3-0.2-0.5-0.4-0.7 0-0.3 0-0.4 0.1-0.6 0-0.3-0.1-0.3-0.5-0.3-0.2-0.2 0-0.3 0-0.3 1-1.6 0.3-0.4 0.3-0.2 1.3-0.5 0.3-0.3 0.2-0.4 0.1-0.7 0.1-0.5 0.4-0.9 0.6-0.6 12.6-7.8 0.4-0.5 0.2-0.4-0.1-0.2-0.8-1.2-0.3-0.3-0.3-0.1-1.2 0.1-2.1-0.3-0.7-0.2-0.3-0.1-0.2-0.2 0-0.2 1.7-2.9 0.3-0.4 0.4-0.1 5.3-0.1 7.1 2 2.5-0.1 0.9-0.2 0.3-0.2 0.2-0.3 0.1-0.7 0.2-0.8 0.6 0 0.5 0.3 0.7 0.6 0.3 0.4 0.5 0.7 0.3 0.1 0.3 0.1 1 0.1 0.3 0.2 0.3 0.2 0 0.5-0.2 0.2-0.3 0.1-1.5 0.1-0.2 0.1-0.3 0.6-0.3 1 0 0.4 0.2 0.3 0.4 0.4 2.5 1 1.5 1 0.5 0.1 0.3-0.1 0.2-0.1 1.5-1.4 0.2-0.1 0.3-0.1 2.3 0.1 0.2 0.1 0.2 0.2 0 0.4-0.4 2.2 0 0.7 0 1.2-0.1 0.7-0.3 0.5-0.6 0.6-0.2 0.3 0.1 0.7 0.3 1 1.6 3.9 0 0.3 0 0.4-0.2 0.2-0.2 0.2-0.2 0.1-0.9 0.1-0.3 0-0.1 0.3 0 0.5-0.1 0.3-0.2 0.1-0.7-0.1-0.3 0.1-0.2 0.2-0.1 0.2-0.3 0.7 0 0.3 0.1 0.2 0.3 0.2 0.7 0.2 0.4 0.2 0.5 0.6 0.4 0.2 0.3 0.1 6.3 0.4 2.2 0.7 0.2 0.9 0.6 2 0.2 0.8 0.1 0.6 0 1.2 0 0.4 0.2 0.3 0.5 0.3 0.8 0.2 0.3 0.1 0.7 0.1 0.2 0.2 0.1 0.4 0 0.5-0.1 0.4 0 0.5 0.2 0.6 0.5 0.9 0.2 0.9 0.1 0.6-0.1 0.4-0.3 0.6-0.3 0.6-0.7 1-0.1 0.2-0.2 0.7 0 0.7 0.2 0.4 0.2 0.1 1.1-0.3 0.3 0 0.3 0.1 0.1 0.3-0.1 0.5-0.2 0.7-0.9 1.2-1.3 1.1-0.3 0.4-0.2 0.4-0.2 0.6 0 0.3 0.2 2.1 0.2 1.3 0 0.4-0.1 0.7 0 0.4 0.1 0.3 0.7 1.8 0.1 0.5 0 0.5-0.1 0.3-0.4 1-0.1 0.7 0.2 0.3 0.2 0.3 0.6 0.5 0.7 0.3 0.8 0.2 0.3 0.1 0.2 0.3 0.1 0.8-0.1 0.6-0.3 0.7-0.2 0.7-0.1 1.1 0 0.6 0 0.6 0.1 0.4 0.3 0.4 0.8 0.5 0.4 0.3 0.5 0.1 0.3 0 0.3 0.1 0.7 0.4 0.3 0.1 0.3 0 0.5-0.4 0.3-0.4 0.2-0.2 0.3 0.1 0.4 0.4 0.6 1.1 0.3 0.6 0.2 1 0.2 0.3 0.4 0.4 1.1 0.9 0.6 0.8 0.7 1.6 0.2 0.4-0.1 0.4-0.2 0.6-0.4 0.4-0.4 0.2-2.5 0.4-0.3 0.2-0.2 0.2 0 0.5 0.2 0.6 0.3 1 0.3 1.1 0.2 0.3 0.3 0.3 0.7 0.3 0.9 0.2 5.5-0.6 0.3 0.1 0.2 0.3 0.4 0.7 0.2 0.8 0.2 0.8 0.2 0.8 0.9 2 0.2 0.5-0.1 0.3-0.1 0.7-0.2 1 0.2 0.3 0.3 0.4 1.1 0.7 7 2.7 3.2 1.9 0.2 0.2 0.2 0.4 0.1 0.8-0.1 0.4-0.1 0.4-0.5 0.7-0.4 0.9-0.2 0.7 0.3 0.5 0.6 0.6 1.4 1.3 0.7 0.4 0.5 0.2 3.9-0.1 5.2 1.2 0.2 0.5 0.2 0.8-0.2 2.7 0 0.7 0.3 0.6 0.2 0.4 0.3 0.2 0.5 0.3 0.1 1.2-1 4.3-0.1 0.7z"
-        id="4" name="Donets'k" fill="{{ if (index .alerts 4) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="4" name="Donets'k" fill="{{ if (index .alerts "4") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M880.7 218.2l0.1 0.5 0.2 0.8 0.9 2.1 0.4 0.7 0.4 0.4 1.1 0.7 0.1 0.2 0 0.3-0.3 0.3-0.3 0.1-0.3 0.1-1.2 0.1-1.1 0.3-1 0.5-0.3 0.4-0.1 0.4 0.2 0.5 2.4 1.8 0.1 0.2-0.1 0.3-0.2 0.3-0.2 0.2-0.8 0.4-0.3 0.5-0.2 0.2-0.5 1.3-0.4 0.1-1.3-0.3-0.3 0.1-0.2 0.1-0.6 0.7-0.7 1.1-0.1 0.3 0 0.3 0.1 0.5 0.3 0.2 0.2 0 1.5-0.6 0.3 0 0.3 0 0.2 0.1 0.4 0.4 0.1 0.3 0 0.3-0.1 2.4 0 1.1 0.1 0.4 0 0.6-0.1 0.4-0.4 0.5-0.6 0.8-0.2 0.4-0.5 1.5-0.3 0.4-0.2 0.3-0.5 0.2-0.2 0.2-0.1 0.4 0 0.5 0.4 1.2 0.1 0.2 0.5 0.7 0 0.2 0 0.3-0.6 0.4 0 0.4 0.6 1.7 0.1 0.6-0.1 0.3-0.3 0.5-0.2 0.3-1.2 0.8-0.2 0.2-0.2 0.3-0.3 1.3-0.2 1.4-0.3 0.8-0.3 0.4-0.3 0.2-0.9 0.2-0.4 0.3-0.7 1-0.1 0.3-0.1 0.3 0.2 0.5 0.2 0.3 0.6 0.7 0.1 0.2 0.2 0.6 0.1 0.5 0.1 0.6-0.3 4.3 0 1 0.2 0.7 0.3 0.1 0.9 0.1 0.6 0.3 0.3 0.2 0.3 0.4 0 0.3 0 0.5-0.1 0.6-0.9 1.3-0.7 0.8-1.5 1.1-1.3 1.2-0.2 0.8-0.1 0.7-0.2 0.3-0.3 0.2-0.9 0.2-2.5 0.1-7.1-2-5.3 0.1-0.4 0.1-0.3 0.4-1.7 2.9 0 0.2 0.2 0.2 0.3 0.1 0.7 0.2 2.1 0.3 1.2-0.1 0.3 0.1 0.3 0.3 0.8 1.2 0.1 0.2-0.2 0.4-0.4 0.5-12.6 7.8-0.6 0.6-0.4 0.9-0.1 0.5-0.1 0.7-0.2 0.4-0.3 0.3-1.3 0.5-0.3 0.2-0.3 0.4-1 1.6 0 0.3 0 0.3 0.2 0.2 0.5 0.3 0.1 0.3 0 0.3-0.1 0.6 0 0.4 0 0.3 0.4 0.7 0.2 0.5 0 0.3-0.2 0.3-0.3 0.2-0.7 0.2-1.5 0-0.4 0.2-2.1 1.5-0.2 0.3-0.3 0.8-0.3 0.7-0.1 0.3-0.3 0.2-0.4 0.1-0.3 0.2-0.3 0.5-0.1 0.4-0.1 0.3 0.2 1.5 0 0.3-0.2 0.3-0.4 0.2-1.2 0.3-0.5 0-0.6-0.1-0.2-0.2-0.4-0.4-0.5-0.7-0.2-0.2-0.3-0.1-0.3 0-0.6 0-0.3 0.2-0.2 0.3-0.2 0.6-0.2 1.2-0.2 0.3-0.3 0.1-0.6 0-0.4-0.1-0.6-0.3-0.3 0-0.3 0.1-0.3 0.3-0.7 1-0.1 0.3 0 0.5 0.1 0.2 0.3 0.3 1.2 0.5 0.2 0.2 0.1 0.2 0 0.3 0 0.4-0.2 0.2-0.3 0.2-0.5-0.1-0.3-0.1-0.6-0.5-5.3-2.7-0.7-0.1-0.3 0-0.5 0.1-0.6 0.2-0.2 0.1-0.8 0.7-0.6 0.6-0.6 0.6-0.3 0.1-2.6-0.2-0.7-0.2-1.6-0.9-0.7-0.2-0.6 0-0.3 0.1-0.3 0.2-0.2 0.4-0.2 0.3 0 0.7 0.2 0.9 0.9 3.7 0 0.7 0.1 1.5-0.2 2.3 0.2 1 0.8 2-3.2 0.4-1.1 0.7-0.4 0.5-0.5 0.2-2 0.2-0.7-0.1-0.4-0.2-0.2-0.2-0.3-0.5-0.3-0.4-0.9-0.7-2.4-1.4-0.9-0.3-0.5 0-0.3 0.1-0.9 0.6-4 4.9-0.9 1.5-0.1 0.3 0.1 0.3 0.1 0.1 0.5 0.4 0 0.3-0.1 0.3-1 0.7-0.4 0.2-0.4 0-0.3-0.2-0.4-0.4-0.6-0.3-0.2 0.1-0.2 0.2-0.5 0.5-0.5 0.4-0.3 0-0.2-0.1-0.6-0.6-0.1-0.2 0.2-0.5 1.5-1.7 0.2-0.5-0.2-0.4-0.9-1-0.9-0.7-0.2-0.2 0-0.3 0.2-0.5 0.7-1.4 0.2-0.6-0.2-0.5-0.7-0.8-3.9-3.3-1.4-1.4-2.1-1.5-0.2-0.2-0.1-0.3 0.1-0.3 0.2-0.6 0.2-0.2 1.1-0.8 0.3-0.3 0.3-0.4-0.1-0.4-0.3-0.4-1.5-1.1-0.3-0.3-0.3-0.7-0.4-0.4-0.5-0.4-1.2-0.7-0.5-0.4-0.2-0.4 0-0.4-0.2-0.4-0.5-0.3-1.4-0.4-0.5-0.3-0.9-0.8-0.4-0.3-0.3 0-1.8 0.1-0.3-0.2 0-0.2 0.1-0.2 0.6-0.6 1.4-0.8 0.4-0.4 0.6-1.1 0.2-0.5-0.2-0.4-1-1.2-0.4-0.5 0-0.3 0-0.7-0.2-0.6-0.5-0.7-1.5-1.4-0.7-0.5-0.5-0.1-0.7 0.4-2.6 1-1 0.5-0.9 0.7-0.2 0.1-0.5 0.2-0.3 0-0.5 0.2-0.5 0.4-0.4 0-0.6 0-0.5-0.4-1.2-0.5-2.3-0.7-0.4 0-0.5 0.3-0.3 0.2-0.5 0-0.4-0.2-0.5-0.5-0.4-0.2-0.4-0.1-1.7 0.1-0.3-0.1-0.3-0.2-0.3-0.3-0.9-0.8-0.4-0.1-0.3 0.1-0.5 0.2-0.3 0.1-2.8-0.5-0.3 0-1.1 0.3-0.7 0-0.4-0.1-0.4-0.1-1.1-1.3-0.9-0.7-3.2-1.9-0.8-0.8-1-1.3-0.2-0.3-1.5-1-0.3-0.3-0.2-0.4-0.3-0.5-3.6-2.9-0.2-0.3-0.2-0.8-0.3-0.4-0.4-0.4-1.6-1.2-1-0.4-0.3-0.1-1 0-1.4-0.3-0.4 0-1.2 0.3-0.6-0.1-0.4-0.2-0.5-0.4-0.4-0.1-0.3 0-0.2 0.1-0.7 0.2-1.9-0.4 0.2-0.5 0.1-0.6-0.1-1-0.3-1-0.2-0.9-0.2-0.5-1.1-1.9-0.1-0.3 0-0.3 0-0.5 0.2-0.8 0.4-0.8 0.5-0.8 2.8-3.1 0.5-0.2 0.3-0.1 0.3 0.1 0.5 0.3 0.5 0.7 0.6 0.5 0.6 0.3 0.4 0.1 5.4 0.5 1.2-0.2 2.9-1.5 0.9-0.7 1.7-1.9 1.4-2.3 1.4-1.9 0.1-0.4-0.1-0.4-0.6-0.8 0-0.4 0.2-0.4 0-0.3-0.1-0.3-0.4-0.3-0.7-0.4-0.3-0.3-0.1-0.6-0.4-0.8-0.2-0.5 0-0.6 0.1-0.4 0.3-0.7 0.1-0.2 0.2-0.2 0.9-0.2 0.5-0.2 0.3-0.1 0.3 0.1 3.2 0.8 0.3 0 0.2-0.2 0.3-0.5 0.2-0.2 0.3-0.1 0.9-0.1 0.3-0.1 0.1-0.1 0.1-0.4-0.2-0.5-0.6-0.6-1.2-0.7-0.9-1-1.1-0.6-0.2-0.3-0.1-0.6 0.1-0.2 0.3-0.1 2.6 0 1.2-0.2 0.5-0.3-0.2-0.7-0.4-1.2-2.5-4.7-0.2-0.5-0.2-0.6 0.1-0.5 0.1-0.2 0.5-0.3 0.2-0.1 0-0.3-0.2-1.2-0.1-0.2-0.2-0.3-0.4-0.1-1.7 0-0.3 0.1-0.2 0.2-0.2 0.2-0.2 0.7-0.1 0.3-0.2 0.2-0.3 0-0.7-0.1-2.8-0.9-0.3-0.1-0.2-0.3 0-0.4-0.1-0.3-0.2-0.3-1.5-0.3-0.4-0.1-0.2-0.3-0.1-0.6-0.1-1.1-0.1-0.3-0.2-0.3-1.1-0.8-0.2-0.2-0.2-0.4-0.3-0.5-0.3-1.4-0.1-0.4 0-1.3 0.2-2.4-0.1-0.7-0.1-0.6-0.4-0.7-0.9-0.9-0.4-0.2-0.3-0.1-0.3-0.1-0.6 0.2-0.7 0.5-0.6 0.1-0.6-0.1-3.5-1.7-0.3-0.3-0.7-1.1-0.4-0.2-0.4-0.2-2.3 0-0.7-0.1-0.6-0.3-0.4-0.4-0.5-0.6-0.5-0.4-1.1-0.3-0.3-0.1-0.2-0.3-0.6-1.1-0.2-0.2-0.3-0.3-0.3-0.5-0.3-2.4-0.1-0.3-0.8-2.3 0-0.2 0.6-0.9 0.5-0.8 2.5-2.4 0.4-0.5 0.1-0.7 0.1-1.2 0-4.7-0.5-1.2 2-1.1 0.7-0.7 0.2-0.8 0.5-1.2 0.3-0.2 0.2 0 0.3 0.4 0.4 0.4 0.7 0.6 0.6 0.3 0.5 0.2 0.3-0.1 0.2-0.3 0.2-0.6-0.1-0.5 0-0.4 0.1-0.5 0.8-1 0.5-0.4 0.4-0.1 0.8 0.6 0.4 0.1 0.2-0.1 0.2-0.2 0.2-0.8 0.2-0.4 0.3-0.5 0.6-0.8 0.4-0.3 0.5-0.2 0.7 0.1 0.7 0.1 1.1 0.6 0.3 0.2 0.4 0.1 0.7 0.1 1.9-0.4 0.5-0.2 0.2-0.2 0.1-0.3-0.1-0.6-0.3-0.8 0.1-0.4 0.1-0.3 0.4-0.6 0.3-0.2 0.2-0.1 1.7 0.4 0.3 0 0.3-0.2 0.1-0.2 0-0.2-0.3-0.4 0-0.3 0.1-0.4 0.7-0.7 0.5-0.2 0.4-0.1 0.3 0.1 1.3 0.8 0.4 0.2 0.4 0 0.3-0.1 0.2-0.2 0.1-0.3 0-0.4 0-1.3 0-0.4 0.1-0.5 0.3-0.2 0.3-0.2 0.3 0 0.3 0.1 0.6 0.3 3.8 2.4 0.5 0.1 0.2-0.1 0.1-0.2-0.2-0.2-0.4-0.5-0.1-0.2 0-0.4 0.3-0.2 1.7 0.4 1.7-0.2 0.8-0.4 0.6-0.1 0.4-0.1 1.8 0.5 1 0.2 0.9-0.1 0.5-0.1 0.4-0.1 0.2-0.2 0.3-0.6 0.2-0.7 0.1-0.5 0.2-0.1 0.8-0.9 0.6-1.1 0.9-1.1 0.5-0.2 1-0.1 0.5-0.2 0.6-0.6 1.1-1.6 0.8-0.6 1-0.3 5.2-0.6 9.7 0.8 1.5 0.8 0.4 1.6 1.6 0.8 5.5 8.9 1.3 0.6 1.3-0.1 1.3-0.5 1.2-0.9 1.1-1.1 0.4-0.2 0.7 0 0.5 0.2 1.2 0.6 1.2 0.3 1.5 1.1 1.8 0.4 0.5 0.2 0.8 0.6 0.2 0.2-0.2 0.4-0.1 1.9-0.1 0.8 0.1 0.8 0.8 0.7 1.2 0.4 1.3 0.2 1.3-0.1 1-0.7 0.5-0.7 0.9-1.9 0.5-0.8 0.7-0.6 9.2-5.5 3.6-1.3 3.7-0.3 3.9 0.6 8.1-2.3 1.6-1.3 3.3-3.3 1.6-0.7 5.6-0.8 1.1 0.6 0.8 2.3 0.8 2.8 0.9 2.3 4.8 2.4 1.8 1.9 0.1 3.6-0.6 2-0.1 1 0.1 0.9 0.3 0.6 2 2 5.3 8.2 1.9 1.4 4.4 2 2.2 1.4 1.9 1.7 1.7 2.2 1.8 3.1 0 0.2 0.9 1.6 0 1.3 0.3 0.5 1.2-0.8z"
-        id="19" name="Kharkiv" fill="{{ if (index .alerts 19) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="19" name="Kharkiv" fill="{{ if (index .alerts "19") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M936.8 391.1l0.1-0.7 1-4.3-0.1-1.2-0.5-0.3-0.3-0.2-0.2-0.4-0.3-0.6 0-0.7 0.2-2.7-0.2-0.8-0.2-0.5-5.2-1.2-3.9 0.1-0.5-0.2-0.7-0.4-1.4-1.3-0.6-0.6-0.3-0.5 0.2-0.7 0.4-0.9 0.5-0.7 0.1-0.4 0.1-0.4-0.1-0.8-0.2-0.4-0.2-0.2-3.2-1.9-7-2.7-1.1-0.7-0.3-0.4-0.2-0.3 0.2-1 0.1-0.7 0.1-0.3-0.2-0.5-0.9-2-0.2-0.8-0.2-0.8-0.2-0.8-0.4-0.7-0.2-0.3-0.3-0.1-5.5 0.6-0.9-0.2-0.7-0.3-0.3-0.3-0.2-0.3-0.3-1.1-0.3-1-0.2-0.6 0-0.5 0.2-0.2 0.3-0.2 2.5-0.4 0.4-0.2 0.4-0.4 0.2-0.6 0.1-0.4-0.2-0.4-0.7-1.6-0.6-0.8-1.1-0.9-0.4-0.4-0.2-0.3-0.2-1-0.3-0.6-0.6-1.1-0.4-0.4-0.3-0.1-0.2 0.2-0.3 0.4-0.5 0.4-0.3 0-0.3-0.1-0.7-0.4-0.3-0.1-0.3 0-0.5-0.1-0.4-0.3-0.8-0.5-0.3-0.4-0.1-0.4 0-0.6 0-0.6 0.1-1.1 0.2-0.7 0.3-0.7 0.1-0.6-0.1-0.8-0.2-0.3-0.3-0.1-0.8-0.2-0.7-0.3-0.6-0.5-0.2-0.3-0.2-0.3 0.1-0.7 0.4-1 0.1-0.3 0-0.5-0.1-0.5-0.7-1.8-0.1-0.3 0-0.4 0.1-0.7 0-0.4-0.2-1.3-0.2-2.1 0-0.3 0.2-0.6 0.2-0.4 0.3-0.4 1.3-1.1 0.9-1.2 0.2-0.7 0.1-0.5-0.1-0.3-0.3-0.1-0.3 0-1.1 0.3-0.2-0.1-0.2-0.4 0-0.7 0.2-0.7 0.1-0.2 0.7-1 0.3-0.6 0.3-0.6 0.1-0.4-0.1-0.6-0.2-0.9-0.5-0.9-0.2-0.6 0-0.5 0.1-0.4 0-0.5-0.1-0.4-0.2-0.2-0.7-0.1-0.3-0.1-0.8-0.2-0.5-0.3-0.2-0.3 0-0.4 0-1.2-0.1-0.6-0.2-0.8-0.6-2-0.2-0.9-2.2-0.7-6.3-0.4-0.3-0.1-0.4-0.2-0.5-0.6-0.4-0.2-0.7-0.2-0.3-0.2-0.1-0.2 0-0.3 0.3-0.7 0.1-0.2 0.2-0.2 0.3-0.1 0.7 0.1 0.2-0.1 0.1-0.3 0-0.5 0.1-0.3 0.3 0 0.9-0.1 0.2-0.1 0.2-0.2 0.2-0.2 0-0.4 0-0.3-1.6-3.9-0.3-1-0.1-0.7 0.2-0.3 0.6-0.6 0.3-0.5 0.1-0.7 0-1.2 0-0.7 0.4-2.2 0-0.4-0.2-0.2-0.2-0.1-2.3-0.1-0.3 0.1-0.2 0.1-1.5 1.4-0.2 0.1-0.3 0.1-0.5-0.1-1.5-1-2.5-1-0.4-0.4-0.2-0.3 0-0.4 0.3-1 0.3-0.6 0.2-0.1 1.5-0.1 0.3-0.1 0.2-0.2 0-0.5-0.3-0.2-0.3-0.2-1-0.1-0.3-0.1-0.3-0.1-0.5-0.7-0.3-0.4-0.7-0.6-0.5-0.3-0.6 0 1.3-1.2 1.5-1.1 0.7-0.8 0.9-1.3 0.1-0.6 0-0.5 0-0.3-0.3-0.4-0.3-0.2-0.6-0.3-0.9-0.1-0.3-0.1-0.2-0.7 0-1 0.3-4.3-0.1-0.6-0.1-0.5-0.2-0.6-0.1-0.2-0.6-0.7-0.2-0.3-0.2-0.5 0.1-0.3 0.1-0.3 0.7-1 0.4-0.3 0.9-0.2 0.3-0.2 0.3-0.4 0.3-0.8 0.2-1.4 0.3-1.3 0.2-0.3 0.2-0.2 1.2-0.8 0.2-0.3 0.3-0.5 0.1-0.3-0.1-0.6-0.6-1.7 0-0.4 0.6-0.4 0-0.3 0-0.2-0.5-0.7-0.1-0.2-0.4-1.2 0-0.5 0.1-0.4 0.2-0.2 0.5-0.2 0.2-0.3 0.3-0.4 0.5-1.5 0.2-0.4 0.6-0.8 0.4-0.5 0.1-0.4 0-0.6-0.1-0.4 0-1.1 0.1-2.4 0-0.3-0.1-0.3-0.4-0.4-0.2-0.1-0.3 0-0.3 0-1.5 0.6-0.2 0-0.3-0.2-0.1-0.5 0-0.3 0.1-0.3 0.7-1.1 0.6-0.7 0.2-0.1 0.3-0.1 1.3 0.3 0.4-0.1 0.5-1.3 0.2-0.2 0.3-0.5 0.8-0.4 0.2-0.2 0.2-0.3 0.1-0.3-0.1-0.2-2.4-1.8-0.2-0.5 0.1-0.4 0.3-0.4 1-0.5 1.1-0.3 1.2-0.1 0.3-0.1 0.3-0.1 0.3-0.3 0-0.3-0.1-0.2-1.1-0.7-0.4-0.4-0.4-0.7-0.9-2.1-0.2-0.8-0.1-0.5 2.4-1.5 0.7-0.3 0.6 0.2 1 0.6 0.6 0.2 2.6-1.9 0.3-3.7-0.4-4 0.6-2.6 0.9-0.2 3.5 0.3 1.5-0.3 1 0.2 0.8 0.9 1.7 5 0.7 1 1.1 0.5 2.4 0.2 1 0.4 1.3 0.9 2 0.8 4-0.8 2.7 0.5 0.6-0.2 0.5-0.3 1.3-0.5 0.1 0 0.5 1.7 0.1 1.4 0.3 0.7 0.4 0.4 3.3 2.5 5.8 1.6 1.9 1.8 1.4 2.1 1.4 1.5 5.3-0.3 0.9-0.3 1.1-0.9 1.7-2.6 1-1.1 2.1-0.8 1.5 1.3 3.1 8 1.2 1.8 1.7 1 2 0.5 2.2-0.5 2.2-1 2.1-0.5 2 1.4 1 0.5 3.3 0.3 0.7 0.9 0.6 2 0.9 3.6 1.4 3 1.7 1.1 1.9 0.6 2.3 1.1 2.8 2.7 1.2 0.6 1.5 0 1.7-0.3 1.6-0.7 1.4-0.8 3.3-3.4 1.4-0.5 1 0.1 5.5 1.4 0.8 0.9-0.3 1.8-0.8 1.2-2.1 1.2-1 1-1.1 2.8 0.3 2.7 1.2 2.5 2.2 3.4 2.6 3.1 0.6 1.3-0.1 1.5-0.8 2.9-0.1 3.1-0.4 0.8-1.3 0.7-1.1 0.9-1.8 2.9-1.1 1.2-3.3 2.3-1.5 1.5-1.2 2-0.2 1.2-0.2 2.4-0.2 0.9-0.7 0.4-0.8 0.1-1.7-0.3-1.7 0-4.5 1.6-2.8-0.2-1 0.7-0.4 4.2 0.8 1.1 1.1 0.7 1 1 0.7 1.5 0.5 1.2 0.6 0.8 1.3 0.6 4.4 1 1.9-0.3 0.7 0.1 0.6 0.5 1 1.4 0.7 0.3 1.2-0.6 1-1.3 1.1-1 1.4 0.1 1 1.4 0 1.7-0.8 1.6-1 1.4-2.5 2-2.2 0.3-6.2-2.5-1.2 0.1-1.2 0.6-1.2 1.2-0.4 1-0.4 0.9-0.4 0.8-1.4 0.5-0.5 0.7-3.4 11.3-0.5 2.1 2.1-0.1 1.1-0.4 6.6 0.8 1.5 0.8 1 1.5 0.5 2.2 0 0.6 0.3 1.1-0.2 0.7-0.5 0.1-0.5 0-0.3 0.1 0.2 1.3 0.5 0.6 0.6 0.5 1.7 3.8 0.6 2.2 0.4 1.1 0.6 0.7-1.3 1.3-0.9 1.2-3.8 3.2 3.8 2.4 1.1-0.1 2.9-1.1 1.2 0.3 0.8 1.4-0.1 1.6-0.8 1.5-0.9 1.2-2.7 2-1 1.2-2.8 5.3-0.7 1.6-0.1 1.9 0.8 2.6 0 0.8-0.2 0.1-0.3-0.1-3.1 0.6-0.6 0.4-0.4 0.7 0.1 0.5 0.4 0.4 0.6 1 0.8 1.8 0.1 0.8-0.1 1.3-0.2 0.6-0.6 1.3-0.2 0.7 0.1 0.6 0.2 1.1 0 0.6-0.4 2.3-0.9 2.1-1.4 1.4-1.8 0.4-5.5-1.5-1.7-0.1-3.4 0.6-1.6-0.2-1.3-0.5-2.4 0.7-1.2 0-1-0.4-1.9-1.3-1.1-0.2-8.1 0.8-2.2 0.6-1.1 0.1-1.1-0.3-3-2.1-0.2 0z"
-        id="11" name="Luhans'k" fill="{{ if (index .alerts 11) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="11" name="Luhans'k" fill="{{ if (index .alerts "11") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M708.3 197.8l0.5 1.2 0 4.7-0.1 1.2-0.1 0.7-0.4 0.5-2.5 2.4-0.5 0.8-0.6 0.9 0 0.2 0.8 2.3 0.1 0.3 0.3 2.4 0.3 0.5 0.3 0.3 0.2 0.2 0.6 1.1 0.2 0.3 0.3 0.1 1.1 0.3 0.5 0.4 0.5 0.6 0.4 0.4 0.6 0.3 0.7 0.1 2.3 0 0.4 0.2 0.4 0.2 0.7 1.1 0.3 0.3 3.5 1.7 0.6 0.1 0.6-0.1 0.7-0.5 0.6-0.2 0.3 0.1 0.3 0.1 0.4 0.2 0.9 0.9 0.4 0.7 0.1 0.6 0.1 0.7-0.2 2.4 0 1.3 0.1 0.4 0.3 1.4 0.3 0.5 0.2 0.4 0.2 0.2 1.1 0.8 0.2 0.3 0.1 0.3 0.1 1.1 0.1 0.6 0.2 0.3 0.4 0.1 1.5 0.3 0.2 0.3 0.1 0.3 0 0.4 0.2 0.3 0.3 0.1 2.8 0.9 0.7 0.1 0.3 0 0.2-0.2 0.1-0.3 0.2-0.7 0.2-0.2 0.2-0.2 0.3-0.1 1.7 0 0.4 0.1 0.2 0.3 0.1 0.2 0.2 1.2 0 0.3-0.2 0.1-0.5 0.3-0.1 0.2-0.1 0.5 0.2 0.6 0.2 0.5 2.5 4.7 0.4 1.2 0.2 0.7-0.5 0.3-1.2 0.2-2.6 0-0.3 0.1-0.1 0.2 0.1 0.6 0.2 0.3 1.1 0.6 0.9 1 1.2 0.7 0.6 0.6 0.2 0.5-0.1 0.4-0.1 0.1-0.3 0.1-0.9 0.1-0.3 0.1-0.2 0.2-0.3 0.5-0.2 0.2-0.3 0-3.2-0.8-0.3-0.1-0.3 0.1-0.5 0.2-0.9 0.2-0.2 0.2-0.1 0.2-0.3 0.7-0.1 0.4 0 0.6 0.2 0.5 0.4 0.8 0.1 0.6 0.3 0.3 0.7 0.4 0.4 0.3 0.1 0.3 0 0.3-0.2 0.4 0 0.4 0.6 0.8 0.1 0.4-0.1 0.4-1.4 1.9-1.4 2.3-1.7 1.9-0.9 0.7-2.9 1.5-1.2 0.2-5.4-0.5-0.4-0.1-0.6-0.3-0.6-0.5-0.5-0.7-0.5-0.3-0.3-0.1-0.3 0.1-0.5 0.2-2.8 3.1-0.5 0.8-0.4 0.8-0.2 0.8 0 0.5 0 0.3 0.1 0.3 1.1 1.9 0.2 0.5 0.2 0.9 0.3 1 0.1 1-0.1 0.6-0.2 0.5-1.7-1.1-0.6-0.3-0.6-0.2-0.3 0-0.4 0.1-0.3 0.2-0.5 0.5-0.2 0.5-0.3 1.1-0.3 0.6-0.3 0.1-0.3 0.2-0.7-0.1-0.3-0.1-0.2-0.3-0.1-0.2 0.1-0.3 0.2-0.2 0.4-0.4 0.1-0.2 0-0.3-0.1-0.2-0.2-0.2-1.6-0.6-0.3 0-0.3 0.1-0.5 0.4-0.4 0.1-1.4-0.1-0.3 0.1-0.5 0.2-0.4 0.3-0.6 0.6-0.5 0.7-0.4 0.3-0.5 0.2-4.5 1.4-1.9 0.3-10 4.4-0.6-0.3-0.3 0-0.3 0.1-0.2 0.4 0 0.3 0.1 0.6-0.1 0.7-0.1 0.3-0.7 1-0.8 0.8-1.4 1.2-0.2 0.2-0.2 0.5 0.1 0.5 0.4 0.1 0.6 0 0.2 0.1 0.1 0.2 0 0.3-0.3 0.3-0.4 0.2-0.7 0-1 0.4-0.6 0.5-0.3 0.3-0.4 0.6-0.8 2.4-0.5 1.1-0.2 0.7-0.1 1.1 0 0.6 0.2 0.5 1.6 1.6 0.2 0.2 0.2 0.5 0.2 1.8-0.1 0.3-0.3 0.3-0.6 0.4-1.1 1.2-0.1 0.3-0.1 0.3 0 0.6 0.1 0.3 0.2 0.2 0.7 0.5 0.1 0.3 0.1 0.2-0.1 0.3-0.4 0.4-1.2 1.2-1.6 1.1-0.7-0.1-1.7-2.2-1.7-0.9-2.9-0.5-1-0.4-0.3-0.3-0.7-0.6-0.3-0.2-0.4 0-0.8 0.1-0.4-0.1-6.2-4.4-0.5-0.5-1.1-1.7-0.4-0.5-0.9-0.5-0.9-0.1-1.9-0.1-1-0.3-1.6-1.6-1.7 0.3-0.1 0.3-0.4 0.2-0.5 0.2-1 0.1-0.6 0.1-0.3 0.2-0.3 0.6-0.2 1.5-0.1 0.4-0.2 0.3-0.4 0.3-0.2 0-0.2-0.2 0-0.5-0.2-0.6-0.4-1-0.2-0.2-0.6-0.6-0.4-0.4-2-1-0.5 0-0.4 0.1-0.6 1.1-0.2 0.2-0.4 0.2-0.6 0.2-0.3-0.1-0.2-0.3-0.3-0.3-0.5-0.5-2.4-1-0.4-0.3-0.4-0.4-0.6-0.5-1.7-0.8-0.8-0.2-0.6 0-0.5 0.9-0.5 0.3-0.2 0-0.2-0.2-0.2-0.4-0.3-0.2-1-0.7-0.2-0.2-0.2-0.5-1-1.3-0.2-0.2-0.8-0.7-0.3-0.3 0.1-0.2 0.5-0.3 0.3-0.4 0.2-0.2 0-0.3 0-0.9 0-0.3 0.2-0.1 0.8-0.2 0.3-0.1 0.2-0.2 0.1-0.3-0.1-0.3-0.3-0.3-1.3-0.4-0.4-0.2-0.3-0.3-0.2-0.8-0.2-0.1-5.2-0.5-0.4-0.3-0.2-0.3-0.6-1-0.4-1-1-1.6-0.4-0.4-0.4-0.4-5.8-4.4-13.8-6.8 0.2-4 0-1.4-0.1-0.7-2.3-6.1-0.3-0.5-2.2-2.9-0.3-0.4-0.6-0.8-0.5-1-0.1-0.5 0-0.4 2.2-3.7 0.1-0.2 0.5-0.4 1.2-0.7 0.1-0.2 0.2-0.4 0.2-0.5 0.1-0.9 0-0.6 0-0.4-0.4-1.5-0.4-0.6-1.4-1.1-0.3-0.2-0.2-0.4-0.3-0.6-0.1-0.4-0.1-2.5-0.2-0.5-0.3-0.4-6-2.3-0.3-0.3-0.4-0.3-0.2-0.7 0.1-2.3 0-0.5-0.1-0.9-0.1-0.5-0.2-0.3-1.8-1.5-0.3-0.1-0.2 0.1-0.5 0.2-0.5 0.1-0.3-0.3-0.2-0.4-0.4-0.6-0.8-0.8-0.2-0.2-0.3-0.1-0.2 0-0.3 0.1-0.5 0.3-0.3 0-0.2 0-0.5-0.3-1-1.3-2-3.6-0.3-0.8-0.2-0.7 0.1-0.3 0.2-0.2 0.2-0.2 0.3-0.1 0.3 0.1 0.5 0.2 0.2 0 0.4-0.2 0.2-0.3 0.1-0.5-0.1-1.5 0-0.8 0.5-1.8 0-0.5 0.1-0.5-0.1-0.8-0.2-0.4-0.2-0.3-1.3-0.8-0.2-0.2-0.4-0.6-2.5-5-0.3-0.1-0.3 0-0.8 0.3-0.3 0-0.3 0-1.3-0.6-0.4-0.2-0.4-0.3-0.2-0.4-1.1-3.9-0.1-0.5 0.2-0.2 1.9-0.6 0.2-0.3 0.2-0.5-0.1-1-0.1-0.4-0.1-0.3-0.4-0.4-0.2-0.1-0.3 0-0.5 0.3-0.3 0.4-0.5 0.3-0.5 0.3-0.3 0-0.3-0.2-1-1.5-0.3-0.2-0.6-0.3-1.2-0.2-0.3-0.2-0.4-0.4-0.2-0.5-0.2-0.6-0.1-0.4-0.2-0.3-0.2-0.2-0.8-1-0.3-0.9-0.3-0.6-0.3-0.3-0.3 0-0.5 0-0.6-0.1 1.5-2.2 0.3-0.7-0.1-0.3-0.3-0.5-0.1-0.4 0.1-0.2 0.2-0.3 0.9-1.3 1.4-3.8 3.3 0 0.3 0.1 0.4 0.4 0.3 0.1 0.3 0 0.5-0.2 0.6-0.4 0.6-0.7 1.1-1.9 0.4-0.4 0.4-0.4 3.5-1.6 1.4-0.1 0.4 0.1 0.2 0.2 0.5 0.6 0.4 0.5 0.2 0.1 0.3-0.1 1.7-1.2 0.4-0.1 0.8 0 0.4 0 0.3 0.1 0.3 0.4 0.2 0.6 0.2 0.9 0.2 0.5 0.3 0.5 0.2 0.3 1.3 0.4 5.6 0.2 2.2-0.4 0.5 0 0.3 0.2 0.2 0.5 0.3 0.1 2 0 4.7-2.6 0.9-0.2 2-0.2 0.5-0.2 0.3-0.2 0.3-0.3 0.3-0.8 0.3-0.9 0.3-0.4 0.3-0.4 1.3-0.8 0.9-0.3 0.3-0.2 0.3-0.3 0.3-1 0.3-0.4 0.3-0.4 0.7-0.4 1.3-0.6 0.8-1 2.1-4.1 2.1 1.2 5.3 0.7 0.6-0.1 0.2-0.2 0.3-0.6 0.1-0.3 0.4-0.4 0.6-0.4 1.5-0.5 0.8-0.1 0.5 0.1 0.2 0.2 0.5 0.4 0.5 0.3 1.5 0.7 0.4 0.2 16.8 3.5 4.2 1.9 0.6 0.1 0.4 0 0.5-0.7 1.6-0.4 2.3-0.1 0.8-0.3 0.5-0.3 0-0.3-0.2-0.2-1.7-1-0.1-0.4 0.1-0.3 0.3-0.4 2.9-1.3 1.9-0.5 0.5 0.1 0.3 0.1 0.6 1 0.8 0.8 1.8 1.5 4.2 1.9 0.4 0 0.3-0.1 0.1-0.3-0.1-0.9 0.1-0.3 0.2-0.2 0.3-0.3 0.5-0.2 0.4 0.1 1.2 0.3 0.3-0.1 0.2-0.2 0-0.4-0.1-0.6 0.1-0.3 0.2-0.2 1.2-0.3 0.4-0.1 0.3-0.3 0.5-0.3 1.2-0.2 0.5-0.3 0.3-0.3 0.1-0.4 0.2-0.3 0.4 0 1.8 0.7 0.2 0.2 0.2 0.2 0 0.3-0.2 1.8 0.1 4.4 0 0.6 0.2 0.5 0.2 0.6 1.3 1.8 1.9 2.2 2.4 2.1 0.4 0.4 0.3 0.5 0.2 0.5 0.1 0.6-0.1 0.6-0.5 0.9-0.2 0.7-0.1 0.6 0.2 2.3 0.1 0.6 0.1 0.3 0.3 0.4 0.4 0.4 0.3 0.1 0.2-0.1 0.4-0.6 0.3-0.2 0.6-0.2 0.4 0.1 0.3 0.2 0.3 0.5 0 0.3 0.1 0.6 0 0.3 0.2 0.3 0.5 0.5 0.2 0.3 0 0.3 0.2 0.4 0.3 0.5 2 1.5 0.4 0.4 0.2 0.5 0.1 0.6 0 0.7-0.2 0.7-0.4 0.5-0.5 0.2-1.2 0.2-0.2 0.1-0.2 0.3-0.1 1.1 0 0.4 0.1 0.5 0.5 0.6 0.2 0.3 0 0.4 0 0.7 0.1 0.5 0.3 0 0.2 0 0.8-0.7 0.3-0.2 1-0.4 0.9-0.1 5.4 0.8 2-0.1 0.2-0.1 0.4-0.4 0.2-0.3 0.2-0.6 0.1-0.8 0.2-0.3 0.2-0.3 0.4-0.2 0.3 0 0.4 0.2 0.8 0.7 0.4 0.2 0.3 0 1-0.5 1.4-1.2 1.5-0.2 5.2 1.2z"
-        id="15" name="Poltava" fill="{{ if (index .alerts 15) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="15" name="Poltava" fill="{{ if (index .alerts "15") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M800.6 392.2l0.1 3.4 0.3 0.9 0.6 0.3 0.7 0.1 0.6 0 1.4-0.2 0.4 0 0.2 0.1 0.1 0.2 0.1 0.6 0.1 1.2 0.1 0.5 1.6 3.4 0.7 2.1 0.4 0.5 0.3 0.3 1.4 0.3 0.4 0.2 0.1 0.2 0 0.2-0.2 0.2-0.5 0.2-0.5 0.2-0.3 0.1-0.1 0.3 0 0.7 0.2 0.4 0.2 0.3 0.2 0.2 1 0.2 0.2 0.2 0 0.3-0.2 1-0.1 0.5 0.1 0.5 0.2 0.3 0.2 0.1 0.6-0.1 1.7-0.7 0.6 0.4 0.9 0.8 2.2 2.4 0.9 0.9 0.7 0.4 0.2-0.2 0.4-0.3 1.4-1.2 0.4 0 0.4 0.1 2.4 1.5 0.4 0.4 1.5 1.9 4.3 3.8 0.2 0.2 1.1 1.7 0.3 0.3 0.3 0 0.4-0.3 0.1-0.5 0.2-0.2 0.3-0.1 2.3 0.4 1 0 0.4 0.1 0.1 0.2 0 0.3-0.2 0.3-0.8 1.6-0.2 0.6 0 0.7 0 0.3-0.6 1.1-0.2 0.7-0.1 0.3 0 0.6 0 0.7 0.2 0.7-0.1 0.3-0.3 0.4-0.4 0.3-0.5 0.1-0.1-0.1-0.2-0.5-0.4-0.7-0.6-0.6-0.2-0.1-0.5-0.2-0.5 0-0.2 0.1-0.4 0.3-0.4 0.4-0.2 0.6-0.2 0.7-0.3 0.6-0.5 0.8-0.2 0.6 0 0.3 0 0.9 0 0.3-0.1 0.3-0.2 0.2-0.2 0.2-2 0.7-1.2 0-1-0.1-0.7-0.2-0.6-0.6-0.2 0-0.6-0.1-0.3 0-0.5-0.5-0.5 0.1-0.5 0.2-0.2 0.3-0.1 0.3 0 0.9 0.3 0.7 0.8 1.7 0.1 0.5 0.1 0.5 0.2 2.1-0.1 0.6-0.4 0.3-0.5 0.2-2.9 0.2-0.3 0.1-0.3 0.1-0.2 0.3 0.2 0.5 0.3 0.4 1.3 1.4 0.5 0.3 2.4 1 1 0.6 0.2 0.2 0.3 0.4 0.8 1.4 0.5 0.9 0.6 2.5 0.2 0.5 0.3 0.3 1.9 1.6 0.2 0.1 0.2 0 0.4-0.7 0.4-0.3 0.4 0.1 0.9 1 0.9 0.6 0.2 0.2 0.4 0.5 0.2 0.3-0.1 0.3-0.2 0.2-0.6 0.6-0.4 0.4-0.2 0.5 1.1 1.9 0.1 0.5-0.1 0.4-0.8 0.6-0.5 0.2-0.2 0-0.3-0.1-0.8-0.4-0.3 0-0.6 0.4-0.2 0.2-1.6 1.5-0.2 0.2-0.3 0.5-0.1 0.4 0.1 0.6 1.6 3.1-0.5 0.1-0.6 0.3-1.9 1.5-0.6 0.4-1.9 0.7-2.9 2.9-1.8 0.2 0.5 2-0.8 1.8-1.1 1.8-0.5 2-0.4 2.1-1 2.2-1.4 1.3-1.4-0.4 0-0.6 0.4 0 1.1 0.5 1.1-2.2 0.6-2.9 0-1.4-0.4-0.4-2-2.4-0.9-0.5-1.8-0.6-0.7-0.6-1 0.6-1-0.1-1.5-0.5-0.9 0.3-0.6 0.6-0.5 0.6-0.4 0.2-3.7 0-2.3 1.4-2.7 0.6-1.8 0.8-1.6 1.3-3.8 4-1 1.8-0.4 2.3-0.4 0 0-1.1-0.2-0.3-0.5 0.3-0.6 0.2-0.1 0.7-0.3-0.8 0.2-0.3 0.1-0.5-0.1-0.4-0.7-0.6-0.5-0.9-0.3-0.4-0.4-0.3-1.1-0.5-1 0-2 0.5-4-0.6-7.6 1.4-1.3 0-7.3 4.1-1.6 1.4-4.4 5.2-1.8 1.7-6.6 3.3-1 0.8-0.9 1-1.7 2.4-0.8 0.8-2 1.4-0.8 0.8-5.3 8.7-0.3 0.4-0.8-0.5 1.5-2.6 2-2.1 0.8-1.3 0.2-2-0.9 1-1 0.8-1.1 0.1-1.3-1.1-0.5-1.8 0.3-2.1 1.1-3.1-0.4-0.2-0.9-0.1-0.6-0.2-0.4-0.2-0.7-0.7-0.9-0.6-0.7-1.1-0.5-1.4-0.2-1.5-1.2 1.5 0.4 1.6 1 1.5 0.5 1.2 0.2 1 0.3 0.6 0.2 0.7-0.2 1.2-0.5 0.9-1.9 2-3.2 5-0.2 0.3-2-0.4-0.5-0.5-0.1-1.5 0-0.3-0.3-1.6 0-0.6 0.1-0.6 0.3-0.9 0-0.4 0-0.4-1.4-5.8-0.3-0.7-0.4-0.3-1.5 0-0.6-0.3-0.9-0.5-0.4-0.1-0.8-0.5-1.2-1.1-0.4-0.1-2.6 0.3-0.7-0.1-0.2-0.1-0.1-0.2 0-1.1 0.1-0.3 0.4-0.3 0.2-0.3 0.1-0.3 0-0.5-0.2-0.2-0.3-0.2-2.6-0.2-1.9 0.1-0.5-0.1-0.3-0.2-0.2-0.2-0.3-0.9-1.3-2.1-0.4-0.7 0-0.6 0.1-0.5 0.3-0.5 0.6-0.6 0.1-0.3 0.1-0.6-0.1-0.8-0.2-0.8-0.3-0.7-0.3-0.3-0.4-0.2-1.2-0.2-0.4-0.1-0.4-0.2-1-0.8-0.3-0.1-0.5 0.2-0.4 0-0.5-0.2-0.3-0.2-0.1-0.3-0.1-0.9 0.1-0.6 0.3-0.4 0.2-0.2 0.5-0.1 1.5-0.1 0.5-0.1 0.2-0.2 0.3-0.5 0.3-0.5 0.4-0.3 0.5-0.2 0.2-0.1 0.4-0.5 0.3-1 0.2-0.5 0.4-0.5 0.2-0.5 0-1.9 0.2-0.6 0.1-0.1 0.3-0.2 0.5-0.1 1.5-0.6 0.2-0.2 0.1-0.4 0-0.6-0.3-1.3-0.2-0.5-0.2-0.5-0.4-0.3-0.3-0.1-0.6-0.1-0.4-0.1-0.4-0.3-0.3-0.5-0.6-2.6-0.2-0.5-0.2-0.3-0.3-0.1-2.6-0.9-0.5-0.3-0.7-0.5-0.5-0.3-1.4-0.3-0.3-0.2-0.2-0.3-0.1-0.2-0.1-1.2-0.2-0.8-0.2-0.4-0.1-0.3-0.3-0.2-0.7-0.1-0.5-0.3-0.2-0.2-0.1-0.3-0.1-0.5-0.1-2.8-0.9-3.8-0.2-0.4-0.3-0.2-0.7-0.2-0.5-0.3-0.2-0.3-0.1-0.3 0-0.6-0.3-1.1 0-0.6-0.1-0.9 0.2-1.7 0-0.6-0.5-2.2 0-0.6 0.1-0.3 0.1-0.2 0.3-0.3 0.1-0.2 0.1-0.4 0-0.6-0.1-0.3-0.1-0.3-0.8-0.6-0.4-0.4-0.1-0.3-0.1-0.6 0.1-0.7 0.1-0.7 0.4-0.4 0.8-0.3 0.3-0.3 0.1-0.4-0.2-0.4-0.4-0.1-3.8 0.4-0.5 0.2-0.2 0.2-0.1 0.3-0.1 0.6-0.1 0.3-0.5 0.7-0.3 0.6-0.1 0.2-0.5 0.2-0.6-0.2-0.8-0.4-0.3-0.1-0.8 0.1-0.2 0.1-0.6 0.5-0.5 0.2-0.3 0-0.3 0-0.4-0.2-0.1-0.2-0.1-0.3-0.1-1.3-0.1-0.6-0.6-1.4-2.8-9.2-1.4-3.9 6.4-2.1 7-1.5 7.6-3.6 0.6-0.2 2.8 0.5 4.7 2 5.7 1.1 3.6 0.2 0.5-0.1 0.5-0.6 0.3-0.6 0.1-0.3 0.4-1.7 0-0.6-0.3-0.5-0.3-0.4-1.1-0.9-0.2-0.2 0-0.2 0.1-0.6 0.8-2.1 0.4-1.4 0.4-2.4 0.4-1.3 0.6-3.9 0-0.6-0.2-0.1-0.3-0.2-1.3-0.2-1-0.2-0.3-0.2-0.1-0.2 0-0.3 0.2-1.1 0-0.3-0.2-0.8 0-0.3 0-0.4 0.2-0.5 0.5-0.9 0.2-0.4 0-0.5-0.1-0.3-0.3-0.4-4.3-2.6-0.4-0.4-0.2-0.5 0-0.6 0.2-0.8 0.2-0.6 0.3-0.4 1.5-1.3 0.3-0.2 2.4-0.5 0.6-0.3 0.3-0.3 0.2-0.4 0.1-0.7-0.1-0.3-0.1-0.5-0.3-0.5-0.3-0.1-2-0.5-0.6-0.3-0.3-0.4-0.3-0.5 0-0.3 0-0.7-0.2-0.5-0.2-0.1-0.3-0.1-0.6 0-0.2-0.1-0.2-0.1-0.1-0.3 0-0.4 0.2-0.5 0.3-0.2 0.7-0.5 0.2-0.3 0.1-0.2 0.1-2 0.1-0.5 0.2-0.5-0.1-0.3-0.3-0.7-0.2-0.8-0.1-0.4 0.1-0.4 0.2-0.8 0.3-0.2 0.3-0.2 0.6 0 0.3 0.1 0.2 0.2 0 0.2 0 1 0 0.6 0.1 0.6 0.2 0.5 0.2 0.2 0.3 0.1 3.2 0 1.4-0.9 1.9-1.6 0.8-0.5 0.6-0.2 0.6 0.1 0.5 0 1-0.1 0.5-0.3 0.3-0.2 1.2-0.3 0.9 0 0.6 0.1 1.5 0.7 0.5 0 0.5-0.1 0.9-0.3 3-0.5 0.5 0 1.2 0.4 3.6 1.8 8.7 1.5 0.8 0.5 0.5 0 0.5-0.1 0.9-0.3 0.6-0.4 1.2-1.3 0.9-0.7 0.5-0.3 2.3-0.6 1.6-0.2 0.6 0.1 0.6 0.2 0.4 0.4 0.4 0.7 0.7 0.8 0.5 0.3 2.7 0.6 0.3 0.1 0.4 0.4 0.8 1.3 0.5 0.1 0.6-0.1 3-1.2 3.3-0.8 0.6 0 0.4 0.1 0.2 0.1 0.2 0.2 0.2 0.2 0 0.3 0 0.3-0.2 1.1-0.1 0.4 0.1 0.6 0.3 0.7 0 0.3 0 0.7 0.1 0.2 0.3 0.2 0.6 0 0.5-0.1 1.8-1.3 0.4-0.1 0.3 0.1 0.2 0.5-0.1 0.3-0.3 0.6-0.7 0.8-0.3 0.5-0.1 0.3 0 0.2 0.3 0.3 0.8 0.2 0.3 0.1 0.2 0.1 0.3 0.5 0.1 0.6 0.1 1.1 0 0.4-0.1 0.9 0.1 0.2 0.7 0.3 0.2 0.2 0.2 0.3 0.4 0.3 1.6-0.1 0.1 0 0 0.3-0.1 0.5-0.1 0.6 0.2 0.8 0 0.3 0 0.3-0.4 0.9-0.5 1.4-0.1 0.4-0.3 0.4-0.3 0.1-0.3-0.1-0.4-0.4-0.2-0.2-0.5 0-0.1 0.1-0.1 0.3 0.1 0.6 0.1 0.2 0.5 0.3 0.2 0.2 0.1 0.6 0.1 0.2 0.5 0.2 0.2 0.2 0.4 0.4 0.4 0 2.5-0.2 0.5-0.2 0.2-0.2 0.3-0.6 0.2-0.4 0.4-0.5 0.4-0.1 0.3-0.1 0.6 0 0.2 0.2 0.1 0.5 0.2 1.8 0.1 0.2 0.5 0.2 1.3 0.1 0.3 0.2 0.2 0.1 0.6 0.6 0.4 0.1 0.7 0.1 1.2 0 0.5-0.1 0.4-0.3 0.4-0.6 0.2-0.4 0.6-0.5 0.5-0.2 0.3-0.1 0.6 0.1 0.4 0.4 0.3 0.4 0.2 0.2 0.2 0.1 10.2-1.5z"
-        id="7" name="Zaporizhzhya" fill="{{ if (index .alerts 7) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="7" name="Zaporizhzhya" fill="{{ if (index .alerts "7") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M483.2 159.8l-2.1 2.4-1.6 1-1.3 2-0.1 1.3 1 1.6-0.6 1 0.1 3.2 3.1 4.8 0.1 1.5 1.6 1.2-0.9 1.8-2.2 0 0.1 3.5-2.1 0.3-1.7-1.8-0.4 1.5 0.1 2.6-1.1 0.4-1.6-0.6-1.2 0.3-0.3-1.2-2 0.7 0.7 1.4-0.6 0.7 1.6 4.3 1 3.2-0.8 2.8-1.1 0.1 0.4 2.3 1.2 3.1-0.6 0.9 0.5 1.4-3.4 1.7-0.2-4-0.8-2.7-1.5-0.5-0.3-4.2-1.4 0 0.6-3.8-1.5-1.3-1 0.4-0.1-1.4-1-0.5-0.2-1.6-0.4-2-0.9 0.2-1 0-0.4-2 0.5-1.7-1.3-2.3-1.7-1.5-1.5-1.8-0.7-3.4-0.9-0.6-1.2 0.7-2.4-0.7-0.8 2.8-0.9-0.2-0.6 0.9-0.6-1.1 0.3-2.2 0.1-1.5 0.6-1.3-0.1-1.5-0.1-1.4 0.7-0.8 0.3-1.6-0.3-1.7 0.3-1.5 1.2-1.1 1.1-1 0-1.6-0.5-1.4 1-0.6 1 1.6 0.3-1.7-1.2-2.1 3.5-0.4 0-2.2 5.5 0 0.3 2.5 1.3 0.3 0.8 3 1.5 1.5 1.1 1.8 1.4 0.8-0.5-2 0.7-0.8 5.3 0.5 0.9-2.4 1.8-0.7 1.2-1.1-0.1-2.5 1.2-1.4 3 1.3 2.4 1.3 0.4 4.8z"
-        id="25" name="Kyiv City" fill="{{ if (index .alerts 25) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="25" name="Kyiv City" fill="{{ if (index .alerts "25") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M582.7 537l7.9 1 3.8-0.7 1.7 0 1.7 1.4 2.3-1.1 1.4-0.1 0.5 0.8 0.6 0.6 2.5-0.2 0.7 1-1 1.1-23.2-3.8 0.4-0.5 0.2 0 0.5 0.5z m145.3-16.1l0.8 0.5-0.9 1-2.9 5.8-1.4 1.9-1.3 1.4-2.2 0.8-1.4 1.1-1.9 0.5-1.3 1-2.1 1-1-0.4 0.1-0.7 1.8-2.1 1.9-1.2-0.1-0.3-0.3-0.8 5.8-1.8 2.9-1.5 1.5-3.1 1.3-1.5 0.6-1.3 0.1-0.3z m-172.3 8.4l0.8 0.5-1.3-0.2-2.3-1.2-20.2-4.3-8.7-3.4-1.7-1.5-1.5-2.5-0.4-1.3-0.5-2-0.1-1.5 1 0.4 0.9 3.4 0.9 2.4 1.3 1.6 2.2 1.3 17.9 4.4 7.2 1.8 4.5 2.1z m113.6-107.1l1.4 3.9 2.8 9.2 0.6 1.4 0.1 0.6 0.1 1.3 0.1 0.3 0.1 0.2 0.4 0.2 0.3 0 0.3 0 0.5-0.2 0.6-0.5 0.2-0.1 0.8-0.1 0.3 0.1 0.8 0.4 0.6 0.2 0.5-0.2 0.1-0.2 0.3-0.6 0.5-0.7 0.1-0.3 0.1-0.6 0.1-0.3 0.2-0.2 0.5-0.2 3.8-0.4 0.4 0.1 0.2 0.4-0.1 0.4-0.3 0.3-0.8 0.3-0.4 0.4-0.1 0.7-0.1 0.7 0.1 0.6 0.1 0.3 0.4 0.4 0.8 0.6 0.1 0.3 0.1 0.3 0 0.6-0.1 0.4-0.1 0.2-0.3 0.3-0.1 0.2-0.1 0.3 0 0.6 0.5 2.2 0 0.6-0.2 1.7 0.1 0.9 0 0.6 0.3 1.1 0 0.6 0.1 0.3 0.2 0.3 0.5 0.3 0.7 0.2 0.3 0.2 0.2 0.4 0.9 3.8 0.1 2.8 0.1 0.5 0.1 0.3 0.2 0.2 0.5 0.3 0.7 0.1 0.3 0.2 0.1 0.3 0.2 0.4 0.2 0.8 0.1 1.2 0.1 0.2 0.2 0.3 0.3 0.2 1.4 0.3 0.5 0.3 0.7 0.5 0.5 0.3 2.6 0.9 0.3 0.1 0.2 0.3 0.2 0.5 0.6 2.6 0.3 0.5 0.4 0.3 0.4 0.1 0.6 0.1 0.3 0.1 0.4 0.3 0.2 0.5 0.2 0.5 0.3 1.3 0 0.6-0.1 0.4-0.2 0.2-1.5 0.6-0.5 0.1-0.3 0.2-0.1 0.1-0.2 0.6 0 1.9-0.2 0.5-0.4 0.5-0.2 0.5-0.3 1-0.4 0.5-0.2 0.1-0.5 0.2-0.4 0.3-0.3 0.5-0.3 0.5-0.2 0.2-0.5 0.1-1.5 0.1-0.5 0.1-0.2 0.2-0.3 0.4-0.1 0.6 0.1 0.9 0.1 0.3 0.3 0.2 0.5 0.2 0.4 0 0.5-0.2 0.3 0.1 1 0.8 0.4 0.2 0.4 0.1 1.2 0.2 0.4 0.2 0.3 0.3 0.3 0.7 0.2 0.8 0.1 0.8-0.1 0.6-0.1 0.3-0.6 0.6-0.3 0.5-0.1 0.5 0 0.6 0.4 0.7 1.3 2.1 0.3 0.9 0.2 0.2 0.3 0.2 0.5 0.1 1.9-0.1 2.6 0.2 0.3 0.2 0.2 0.2 0 0.5-0.1 0.3-0.2 0.3-0.4 0.3-0.1 0.3 0 1.1 0.1 0.2 0.2 0.1 0.7 0.1 2.6-0.3 0.4 0.1 1.2 1.1 0.8 0.5 0.4 0.1 0.9 0.5 0.6 0.3 1.5 0 0.4 0.3 0.3 0.7 1.4 5.8 0 0.4 0 0.4-0.3 0.9-0.1 0.6 0 0.6 0.3 1.6 0 0.3 0.1 1.5 0.5 0.5 2 0.4-1.7 1.9-2.4 1.5-5.3 1.3-0.5 0.1-0.7-0.3-0.3-0.5-0.1-0.6-0.1-0.3-0.6 0.3-0.4 0.7-0.1 1-0.2 0.7-2.4 1.2-0.2 0.3-0.9 1.4-0.3 0.7-0.1 0.9 0.1 0.6 0.3 1.3 0.3 5.1 0.3 1.2 0.5 1.3 2 9.6 0.5 1.1 0.7 0.9 0.5 1 2.9 7.9 2.5 4.8-1.4 0.9-2-3.3-0.5-1.4-0.7-3-0.5-1.2-0.3-0.6-0.6-0.7-0.6-0.6-0.6-0.1-0.4 0.4-0.2 0.5-0.1 0.7-0.4 0.6-0.4 0.5-0.5 0.3-0.5 0.2-0.6 0.1-0.3-0.4 0.1-0.8 1.1-4.2-0.1 0-0.2-0.2-0.1-0.5 0-0.4 0.1-0.1 0.5-0.4 0.3-0.1 0.2-0.4 0.1-0.4-0.3-0.3-0.2 0-0.7-0.6-0.2-0.2-0.4-0.7-0.1-0.8 0-1.8-0.2-1.3-0.3-1.9-0.5-1.7-0.6-0.7-0.7 0.2-0.3 0.7-0.2 0.8-0.5 0.4-0.4-0.3-1.7-1.6-0.2-1.2-0.8-1.2-1-1.1-1-0.6 0.5-0.7 0.7-0.5 2.2-0.6 1.3 0.1 0.4 0.3 0.7 1.1 0.5 0.3 0.7-0.6 0-1.3-0.5-1.5-0.4-1-1.6-1.6-2.5-1.5-2.5-0.3-1.8 1.8-0.2 1.7 0.3 1.6 0.4 1.5 0.3 1.4-0.3 1.1-2.9 5.9-1.2 1.1-2.2-0.9-1.8 2.2-1.1-0.3 0.6-1.8-0.6-0.8-1.4-0.2-1.5 0-0.5-0.5 0.3-1 0.6-1.2 0.6-0.5 0.7 0.1 0.6 0.6 0.5 0.2 0.5-0.9-1.1-0.9-0.6-1.3 0-1.4 0.5-1.4 1.9-1.6 0.7 0.1 0 0.4-0.2 0.4-0.1 0.4 0.2 1.4 0.4-0.8 0.7-1.4 0.6-0.5 0.2 0.8 0.6 1.6 0.8 1 0.3-1 0.1-0.8 0.6-1 0.1-0.9 0-2.2-0.2-0.8-0.7-0.8-0.7-0.6-0.6-0.3-0.3 0.4-0.3 0.6-0.3 1.3-1.8 1.3-2-0.5-3.8-3.1-3.3-1.7-0.5-0.2-0.2 0.5-0.4 0.4-0.4 0.5-0.1 0.5 0.4 0.5 1.3 0.2 0.5 0.4 0 1.1-0.2 2.1-0.5 2-0.6 0.8-0.5-0.3-0.1-0.9 0-2-0.2-1-0.2-0.5-2.9-5.9-1.3-2.1-1.7-1.5-1.1-0.3-0.6 0.6-0.2 1.3 0 2 0.3 1.2 0.7 1 2 1.9-2.3 2.4-0.1 0.5-0.2-0.3-0.4-0.9 0.3-1.7 0-0.6-0.4-0.7-0.6-0.7-0.6-0.1-0.3 0.7-0.2 0.9-0.6 0.3-1.5-0.4 0 0.6 0.9 0.6 0.6 0.4 0.6 0.1 0.4 0.4 0.5 1.8 0.3 0.8 0 0.6-0.6 0-1.1-0.3-0.3 0-0.6 0.1-0.3-0.1-0.1-0.4-0.3-1.1 0-0.2-0.6-0.1-0.4 0.2-0.3 0.3-0.5 0.3-0.3 0.3-0.3 0.1-0.2-0.2-0.3-0.7-0.3-0.2-0.7-0.8-1.4-3.3-0.5-0.8-1.2-0.2-3.2-1.4-0.7 0-0.8 0.4-0.6 0.2-1.2 0-0.6-0.1-0.3-0.2-0.4-0.9-1-0.5-1.2-0.2-0.8 0.1-0.9 0.7-0.7 0.9-0.7 0.5-1.1-0.3-1.5 0 0 0.5 0.1 0-0.4 0.6 0.5 2.9-0.6 2-1.8 1.9-0.8-1.6-0.9-0.9-1.4 0.5-1.2 1.1-1 1.1-0.1 1.2 1.5 1 0 0.6-1.5 0.1-1.4 0.4-0.5 1 1.1 1.7-0.5 0-1.2-0.5-3.4 0-0.5-0.4-1.7-2.3-1.5-1.3-1.4-0.3-1.2 1.6-0.2-0.4-0.3-0.1-0.3 0-0.3-0.1 0.9-0.9 0.6-1.1-0.1-0.9-1.2-0.4-4.4 0.6 0.4-1.1 0.2-1 0.5-5.5-1.2 1.4-1 2.2-1.1 2-1.7 0.9-0.5 0.1-0.7 0.4-0.5 0-0.6-0.2-1.1-0.9-3.3-1.6 0.5 2.3-0.8 1.1-5.8 1-0.7-0.1-0.5-0.1-0.5-0.3-0.3 0-1.3 0-0.5 0-3-1.1-1.3-0.1-0.8 0.2-2.1 1.1-7 1.2-0.5 0.2-0.6 0.4-0.9 1-0.4 0.3-2.3 0.4-11.1-3.3-1.2-0.8-0.7 0-0.3-0.1-0.1-0.3-0.2-1-0.3-1-0.2-1.1-0.3-0.5 1-0.9 0.3-0.5 0.2-0.8-1.7 0.7-1.4 0.2-1.5-0.3-2.4-0.9-0.8-0.1-0.7-0.4-2.1-2.8-1.6-1.3-1.7 0.7-2.8-1.5-4.6-3.5-0.1 0.9 0.3 1 0.1 1-0.4 0.3-3.5-0.1-1-0.3-0.9-0.6-0.7-0.9-0.3-1.2 0.7-1.1 1.1-0.8 0.9-0.5 1.2-0.1 3 0.7 4.2-0.8 0.5-0.4 2.9-2.7 0.7-0.1 0.7 0 0.4-0.3-0.5-0.9-2.5-3.5-0.9-0.4-2 0.2-2.5-0.4-5.2-2.1 2-3.2 0.5 0.2 4.7 0 4.8 1.1 3.2-0.5 1.2 0 6 1.4 3.1 1.9 2.2 0.6 2.5-0.2 2.1-1 1.5-1.6-1-1.5 1.5-2.1 3.9-3.3 1-0.7 2.6-0.3 0.9-0.6 0.9-0.9 0.9-0.7 0.8-0.7 0.6-1.4-1.3 0.7-1.5 1.1-1.5 0.8-1.3-0.3-7.5 4.2-4 0.6-3.7-2.1-0.9-1.3-0.3-0.3-0.6 0.3-0.9 1.1-0.6 0.2-0.6 0.1-1.1 0.4-0.5 0.1-1.3 1.6-0.8-0.8-0.8-2.9-0.9-0.7-3.4-0.7-1-0.4-0.7-0.9-0.9-1.4-0.7-1.6-0.3-1.3-0.3-1.6-0.3-0.7 0.1-0.4 2.8 0.1 0.8-0.3 0.5-0.5 0.9-0.7 0.5-0.1 0.4-0.1 0.6 0.1 0.9 0.6 0.7 0.2 0.6 0 1.7-0.5 1-0.4 0.4-0.3 0.3-0.4 0.2-0.3 0.5-1 0.6-1.5 0.1-0.4 0.2-0.4 0.5-0.4 0.3-0.2 0.4-0.2 0.7-0.1 0.6 0 3.2 1.2 1.5 0.1 0.4-0.1 0.3-0.2 0.1-0.2 0.7-2 0.2-0.4 0.4-0.5 0.4-0.2 1.9-0.5 1.2-0.1 0.3 0 0.2 0.1 0.2 0.2 0.3 0.8 0.4 0.2 0.5 0.1 2-0.2 0.4-0.1 0.3-0.2 0.3-0.4 0.2-0.7 0-0.3 0-1.2 0.1-0.4 0.2-0.1 0.3 0 0.2 0.1 0.4 0.4 0.2 0.1 0.5 0.1 0.2 0.1 0.4 0.2 0.6 0.1 2.2 0 0.4 0.1 0.4 0.3 0.3 0.2 1.2 0.4 0.3 0.1 0.3 0.4 0.4 0.7 0.3 0.4 0.2 0.2 0.4 0.2 0.6 0 3-0.4 2.7 0.1 0.3-0.1 0.5-0.3 4.3-1.5 0.9-0.5 0.6-0.4-0.2-0.2-0.2-0.1-0.5 0.1-0.3-0.1-0.5-0.3-0.4-0.1-3 0.3-1.3-0.1-0.4-0.2-0.4-0.2-0.2-0.2-0.1-0.3 0-0.3 0.3-0.4 0.3-0.1 5.4-0.9 0.5-0.2 1.2-1.4 0.8-0.4 2.2-0.6 0.6-0.3 0.3-0.4-0.1-0.2-0.3-0.4-0.4-0.2-2 0-0.2-0.1-0.4-0.4-0.2-0.5 0-0.6-0.1-0.9 0.1-0.4 0.2-0.4 0.6-0.5 0.5-0.2 0.4 0.3 0.3 0.4 0.3 0.4 0.3 0.3 1.5 1 0.1-0.1 0-0.5 0.1-0.4 0.2-0.3 1-1.1 0.4-0.7 0.2-0.6 0-0.2-0.1-0.3-2.5-2.4-3.2-1.9-0.6-0.1-0.8 0.1-0.3 0-0.2-0.2-0.1-0.2-0.1-0.8-0.1-0.6-0.1-0.5 0.2-0.6 1-1.3 1.4-3.1 0.5-1.4 0.3-0.4 0.3-0.1 0.2 0.1 0.6 0.7 0.1 0.1 1.3 0 0.8-0.1 0.4-0.2 0.3-0.2 1.6-1.9 0.4-0.2 0.3-0.1 0.3 0.1 0.7 0.4 0.3 0.1 0.4 0 0.3-0.2 0.1-0.3 0.2-0.6 0.3-0.7 0-0.2-0.1-0.3-1.2-2.1-0.3-0.8-0.2-0.5 0-0.6 0.1-0.7 0.3-1 0.5-1.5 0.2-0.7 0-0.5 0-0.5-0.2-0.8-1.2-2.7-0.1-0.2-0.3-0.1-0.2-0.1-1.9 0-0.6-0.2-0.4-0.4-0.3-0.4-0.2-0.5 0-0.2 0.3-0.7 0.8-1.2 0.2-0.4 0.2-0.7 0.1-0.5 0.2-0.1 0.2 0.1 0.1 0.2 0.6 1.2 0.3 1.1 0.1 0.2 0.1 0.2 0.6 0.2 1.1-0.1 0.5-0.2 0.2-0.2 0.1-0.3 0-0.6-0.4-0.9-0.1-0.6 0-0.4 0.1-0.7 0.2-0.6 0-0.7-0.2-0.4-0.2-0.6-0.4-0.8 0-0.5 0-0.3 0.4-0.9 0-0.3-0.2-0.5-0.3-0.3-0.8-0.7-0.3-0.5-0.1-0.5-0.1-0.6 0.1-1 0.1-0.7 4.2 1.2 0.3 0.1 0.8 0.7 0.4 0.4 4.1 0.8 0.9 0.4 0.6 0.3 0.2 0.5 0.2 0.8 0.2 0.5 0.1 0.2 0.4 0.4 0.5 0.5 0.6 0.3 0.3 0.1 0.4 0 0.3-0.3 0.2-0.2 0.1-0.5 0.3-0.5 0.4-0.2 5.9-1.2 0.5-0.2 0.4-0.4 0.1-0.3 0-0.6-0.2-0.8 0.1-0.3 0.1-0.2 0.5-0.2 3.3-0.7 0.3 0.1 0.4 0.1 0.4 0.4 0.2 0.3 0.1 0.4 0 0.2-0.5 1-0.2 0.6 0 0.3 0.2 0.5 0.3 1.1 0.2 0.2 0.3 0.1 1.3-0.1 0.4 0 0.3 0.3 0.3 0.5 0.3 0.9 0.1 0.2 0.7 0.2 5.4-0.4 7-1.9 1.3 0 8.6 2.8 5.5 0.5 2.6-0.4z"
-        id="20" name="Kherson" fill="{{ if (index .alerts 20) }}#dd5522{{ else }}#77aa55{{ end }}">
+        id="20" name="Kherson" fill="{{ if (index .alerts "20") }}#dd5522{{ else }}#77aa55{{ end }}">
     </path>
     <path
         d="M643 667.7l-0.5-0.1-4.1-2-0.8-0.7-0.3-1.5-0.3-0.7-1.7-2.2-0.6-0.5-1 0.1-1.2 0.3-1.2 0.1-1-0.8-0.7-0.7-6-4.2-0.4-1 0-0.9 0.2 0.2 1.7-0.3 0.4-0.3 1.3-0.9 0.5-0.1 1.2 0 0.6 0 0.4-0.3 0.8-0.3 2.8 0 1.2-0.5-0.8-0.3-1.8-0.3-0.5-0.5-0.1-1.1 0.5-1 0.7-0.8 0.5-0.8 0.2-1.3-0.1-0.8-0.6-1.6-0.3-2.2-0.3-1.1-0.5-1 0.5-0.4 0.1-0.3 0.2-0.4 0.4-1.6 0.5-1.4 0.7-1 0.6-0.2 0.6 2.6 2 0.2 3.2 2 0.8 2.9-4.3 2.6 0.7 4.6 4.7-0.4 2.3 3.3-2.1 3.3 0.9 2.2 2.2-0.2 0.5 1.9 1.5 0.4 2.1 5.5 0.5 1.9-3.7 0.5-1 0.5-0.1 1-2 0.6z"
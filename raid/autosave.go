@@ -0,0 +1,97 @@
+package raid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type Saver interface {
+	Save() error
+}
+
+// Autosave saves updater state every N updates, or after an interval if the state changed in any other way,
+// e.g. only LastMessageID was updated.
+type Autosave struct {
+	saver        Saver
+	updaterState *UpdaterState
+	updates      *Topic[Update]
+	everyUpdates int
+	interval     time.Duration
+	savedVersion uint64
+}
+
+func NewAutosave(
+	saver Saver, updaterState *UpdaterState, updates *Topic[Update], everyUpdates int, interval time.Duration,
+) *Autosave {
+	return &Autosave{
+		saver:        saver,
+		updaterState: updaterState,
+		updates:      updates,
+		everyUpdates: everyUpdates,
+		interval:     interval,
+		savedVersion: updaterState.Snapshot().Version,
+	}
+}
+
+func (a *Autosave) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("autosave: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	events := a.updates.Subscribe("autosave", OverflowBlock, func(u Update) bool {
+		return true
+	})
+	defer a.updates.Unsubscribe(events)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	pending := 0
+
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if pending++; pending < a.everyUpdates {
+				continue
+			}
+		case <-ticker.C:
+			if a.updaterState.Snapshot().Version == a.savedVersion {
+				continue
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		// Failed saves are retried on the next update or tick, the state is still saved on shutdown anyway.
+		if err := a.save(); err != nil {
+			log.Error(err)
+
+			continue
+		}
+
+		pending = 0
+	}
+}
+
+func (a *Autosave) save() error {
+	version := a.updaterState.Snapshot().Version
+
+	if err := a.saver.Save(); err != nil {
+		return fmt.Errorf("autosave: save: %w", err)
+	}
+
+	log.Debugf("autosave: save state version %d", version)
+
+	a.savedVersion = version
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package raid
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingSaver struct {
+	saves chan struct{}
+}
+
+func (s *countingSaver) Save() error {
+	s.saves <- struct{}{}
+
+	return nil
+}
+
+// expectSaves fails if the number of saves within timeout differs from want.
+func (s *countingSaver) expectSaves(t *testing.T, want int, timeout time.Duration) {
+	t.Helper()
+
+	deadline := time.After(timeout)
+
+	for got := 0; ; {
+		select {
+		case <-s.saves:
+			if got++; got > want {
+				t.Fatalf("got more than %d saves", want)
+			}
+		case <-deadline:
+			if got != want {
+				t.Fatalf("got %d saves, want %d", got, want)
+			}
+
+			return
+		}
+	}
+}
+
+func runTestAutosave(t *testing.T, everyUpdates int, interval time.Duration) (*countingSaver, *UpdaterState, *Topic[Update]) {
+	t.Helper()
+
+	updaterState := NewUpdaterState()
+	updates := NewTopic[Update]()
+	saver := &countingSaver{make(chan struct{}, 16)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	go NewAutosave(saver, updaterState, updates, everyUpdates, interval).Run(ctx, wg, make(chan error, 1))
+
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	for len(updates.Stats()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	return saver, updaterState, updates
+}
+
+func TestAutosaveEveryUpdates(t *testing.T) {
+	saver, updaterState, updates := runTestAutosave(t, 2, time.Hour)
+
+	for i := 0; i < 5; i++ {
+		_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+			data.LastEventID++
+
+			return nil
+		})
+		updates.Broadcast(Update{})
+	}
+
+	saver.expectSaves(t, 2, 100*time.Millisecond)
+}
+
+func TestAutosaveInterval(t *testing.T) {
+	saver, updaterState, _ := runTestAutosave(t, 2, 20*time.Millisecond)
+
+	// Nothing changed yet.
+	saver.expectSaves(t, 0, 100*time.Millisecond)
+
+	// Changes without updates, e.g. of LastMessageID, are saved on the next tick, and only once.
+	_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+		data.LastMessageID++
+
+		return nil
+	})
+
+	saver.expectSaves(t, 1, 100*time.Millisecond)
+}
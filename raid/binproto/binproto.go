@@ -0,0 +1,221 @@
+// Package binproto implements binary framing of TCP protocol for microcontrollers.
+//
+// Every frame is:
+//
+//	0xA5 | type (1 byte) | payload length (1 byte) | payload | CRC-8 of type, length and payload (1 byte)
+//
+// Numbers are big-endian. Frame types and their payloads:
+//
+//	0x01 auth    status (1)
+//	0x02 state   event ID (4) | alert type (1) | number of states N (1) | bitmap of alerts in states (ceil(N/8)),
+//	             where bit i of byte j (least significant first) is state with ID 8*j+i+1
+//	0x03 change  event ID (4) | region ID (2) | alert type (1) | alert (1) | change time (4, Unix time or 0)
+//	0x04 ping    server time (4, Unix time) | degraded (1)
+//
+// Frames are also announced to local networks over UDP in signed datagrams, see Datagram.
+package binproto
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const SyncByte = 0xA5
+
+type FrameType uint8
+
+const (
+	FrameAuth   FrameType = 0x01
+	FrameState  FrameType = 0x02
+	FrameChange FrameType = 0x03
+	FramePing   FrameType = 0x04
+)
+
+type AuthStatus uint8
+
+const (
+	AuthOK AuthStatus = iota
+	AuthTimeout
+	AuthWrongAPIKey
+	// 3 was "wrong alert type", which is not an error anymore since all alert types are sent.
+	_
+	AuthWrongRegion
+)
+
+// Alert types are encoded by their index in this list.
+var AlertTypes = []string{"air_raid", "artillery", "urban_fights", "chemical", "nuclear"}
+
+var (
+	ErrSync     = errors.New("binproto: sync byte expected")
+	ErrChecksum = errors.New("binproto: checksum mismatch")
+	ErrPayload  = errors.New("binproto: invalid payload")
+)
+
+// Frame is one of Auth, State, Change or Ping.
+type Frame interface {
+	Type() FrameType
+	payload() []byte
+}
+
+type Auth struct {
+	Status AuthStatus
+}
+
+type State struct {
+	EventID   uint32
+	AlertType uint8
+	// Alerts[i] is true if there is an alert in state with ID i+1.
+	Alerts []bool
+}
+
+type Change struct {
+	EventID   uint32
+	RegionID  uint16
+	AlertType uint8
+	Alert     bool
+	ChangedAt uint32
+}
+
+type Ping struct {
+	Time     uint32
+	Degraded bool
+}
+
+func (Auth) Type() FrameType   { return FrameAuth }
+func (State) Type() FrameType  { return FrameState }
+func (Change) Type() FrameType { return FrameChange }
+func (Ping) Type() FrameType   { return FramePing }
+
+func (f Auth) payload() []byte {
+	return []byte{byte(f.Status)}
+}
+
+func (f State) payload() []byte {
+	buf := make([]byte, 6+(len(f.Alerts)+7)/8)
+	binary.BigEndian.PutUint32(buf, f.EventID)
+	buf[4] = f.AlertType
+	buf[5] = byte(len(f.Alerts))
+
+	for i, alert := range f.Alerts {
+		if alert {
+			buf[6+i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return buf
+}
+
+func (f Change) payload() []byte {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint32(buf, f.EventID)
+	binary.BigEndian.PutUint16(buf[4:], f.RegionID)
+	buf[6] = f.AlertType
+	buf[7] = boolToByte(f.Alert)
+	binary.BigEndian.PutUint32(buf[8:], f.ChangedAt)
+
+	return buf
+}
+
+func (f Ping) payload() []byte {
+	buf := make([]byte, 5)
+	binary.BigEndian.PutUint32(buf, f.Time)
+	buf[4] = boolToByte(f.Degraded)
+
+	return buf
+}
+
+// Encode returns a frame with header and checksum.
+func Encode(frame Frame) []byte {
+	payload := frame.payload()
+
+	buf := make([]byte, 0, len(payload)+4)
+	buf = append(buf, SyncByte, byte(frame.Type()), byte(len(payload)))
+	buf = append(buf, payload...)
+
+	return append(buf, Checksum(buf[1:]))
+}
+
+// Decode reads a frame. Frames of unknown types are returned as ErrPayload after being read completely,
+// so that decoding can continue.
+func Decode(r io.Reader) (Frame, error) {
+	header := make([]byte, 3)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if header[0] != SyncByte {
+		return nil, ErrSync
+	}
+
+	rest := make([]byte, int(header[2])+1)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	payload, checksum := rest[:len(rest)-1], rest[len(rest)-1]
+	if Checksum(append(header[1:], payload...)) != checksum {
+		return nil, ErrChecksum
+	}
+
+	return decodePayload(FrameType(header[1]), payload)
+}
+
+func decodePayload(frameType FrameType, payload []byte) (Frame, error) {
+	switch {
+	case frameType == FrameAuth && len(payload) == 1:
+		return Auth{AuthStatus(payload[0])}, nil
+	case frameType == FrameState && len(payload) >= 6 && len(payload) == 6+(int(payload[5])+7)/8:
+		frame := State{
+			EventID:   binary.BigEndian.Uint32(payload),
+			AlertType: payload[4],
+			Alerts:    make([]bool, payload[5]),
+		}
+
+		for i := range frame.Alerts {
+			frame.Alerts[i] = payload[6+i/8]&(1<<(i%8)) != 0
+		}
+
+		return frame, nil
+	case frameType == FrameChange && len(payload) == 12:
+		return Change{
+			EventID:   binary.BigEndian.Uint32(payload),
+			RegionID:  binary.BigEndian.Uint16(payload[4:]),
+			AlertType: payload[6],
+			Alert:     payload[7] != 0,
+			ChangedAt: binary.BigEndian.Uint32(payload[8:]),
+		}, nil
+	case frameType == FramePing && len(payload) == 5:
+		return Ping{binary.BigEndian.Uint32(payload), payload[4] != 0}, nil
+	default:
+		return nil, fmt.Errorf("%w: type %d, length %d", ErrPayload, frameType, len(payload))
+	}
+}
+
+// Checksum returns CRC-8 with polynomial 0x07, which is cheap to compute on 8-bit microcontrollers.
+func Checksum(data []byte) byte {
+	var crc byte
+
+	for _, b := range data {
+		crc ^= b
+
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+func boolToByte(value bool) byte {
+	if value {
+		return 1
+	}
+
+	return 0
+}
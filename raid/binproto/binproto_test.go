@@ -0,0 +1,127 @@
+package binproto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	alerts := make([]bool, 25)
+	alerts[0], alerts[11], alerts[24] = true, true, true
+
+	frames := []Frame{
+		Auth{AuthOK},
+		Auth{AuthWrongRegion},
+		State{EventID: 1041, AlertType: 2, Alerts: alerts},
+		State{EventID: 1, AlertType: 0, Alerts: []bool{}},
+		Change{EventID: 0xDEADBEEF, RegionID: 1012, AlertType: 4, Alert: true, ChangedAt: 1666077000},
+		Change{EventID: 7, RegionID: 12},
+		Ping{Time: 1666077000, Degraded: true},
+	}
+
+	for _, frame := range frames {
+		decoded, err := Decode(bytes.NewReader(Encode(frame)))
+		if err != nil {
+			t.Errorf("%#v: %v", frame, err)
+
+			continue
+		}
+
+		if !reflect.DeepEqual(decoded, frame) {
+			t.Errorf("got %#v, want %#v", decoded, frame)
+		}
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	stream := append(Encode(Auth{AuthOK}), Encode(Ping{Time: 1})...)
+	r := bytes.NewReader(stream)
+
+	for _, want := range []Frame{Auth{AuthOK}, Ping{Time: 1}} {
+		if frame, err := Decode(r); err != nil || frame != want {
+			t.Errorf("got %#v, %v, want %#v", frame, err, want)
+		}
+	}
+
+	if _, err := Decode(r); !errors.Is(err, io.EOF) {
+		t.Errorf("got %v, want EOF", err)
+	}
+}
+
+// Byte vectors are a reference for implementations on microcontrollers.
+func TestEncodeVectors(t *testing.T) {
+	alerts := make([]bool, 25)
+	alerts[11] = true // State 12.
+
+	tests := []struct {
+		frame Frame
+		hex   string
+	}{
+		{Auth{AuthOK}, "a50101007e"},
+		{Auth{AuthWrongAPIKey}, "a501010270"},
+		// Bit 3 of the second bitmap byte is state 12, bits are numbered from the least significant.
+		{State{EventID: 1041, AlertType: 0, Alerts: alerts}, "a5020a0000041100190008000024"},
+		{Change{EventID: 1, RegionID: 12, AlertType: 0, Alert: true, ChangedAt: 1666077000}, "a5030c00000001000c0001634e51486d"},
+		{Ping{Time: 1666077000, Degraded: false}, "a50405634e51480011"},
+	}
+
+	for _, test := range tests {
+		if got := hex.EncodeToString(Encode(test.frame)); got != test.hex {
+			t.Errorf("%#v: got %s, want %s", test.frame, got, test.hex)
+		}
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	// Standard check value of CRC-8 with polynomial 0x07, initial value 0 and no reflection.
+	if got := Checksum([]byte("123456789")); got != 0xF4 {
+		t.Errorf("got %#x, want 0xf4", got)
+	}
+
+	if got := Checksum(nil); got != 0 {
+		t.Errorf("got %#x for empty data, want 0", got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	valid := Encode(Ping{Time: 1666077000})
+
+	badSync := append([]byte{}, valid...)
+	badSync[0] = 0x5A
+
+	badChecksum := append([]byte{}, valid...)
+	badChecksum[len(badChecksum)-1] ^= 0xFF
+
+	corrupted := append([]byte{}, valid...)
+	corrupted[4] ^= 0x01
+
+	// Ping payload must be 5 bytes long, checksum is valid.
+	shortPing := []byte{SyncByte, byte(FramePing), 4, 0x63, 0x4e, 0x51, 0x48}
+	shortPing = append(shortPing, Checksum(shortPing[1:]))
+
+	unknownType := []byte{SyncByte, 0x7F, 1, 0}
+	unknownType = append(unknownType, Checksum(unknownType[1:]))
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"bad sync byte", badSync, ErrSync},
+		{"bad checksum", badChecksum, ErrChecksum},
+		{"corrupted payload", corrupted, ErrChecksum},
+		{"wrong payload length", shortPing, ErrPayload},
+		{"unknown type", unknownType, ErrPayload},
+		{"truncated", valid[:len(valid)-2], io.ErrUnexpectedEOF},
+	}
+
+	for _, test := range tests {
+		if _, err := Decode(bytes.NewReader(test.data)); !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.err)
+		}
+	}
+}
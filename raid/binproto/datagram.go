@@ -0,0 +1,87 @@
+package binproto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Size of truncated HMAC-SHA256 which signs datagrams.
+const TagSize = 16
+
+const datagramHeaderSize = 8
+
+var (
+	ErrDatagram  = errors.New("binproto: datagram is too short")
+	ErrSignature = errors.New("binproto: invalid signature")
+)
+
+// Datagram is a signed group of frames sent over UDP:
+//
+//	time (4, Unix time) | sequence number (4) | frames | first 16 bytes of HMAC-SHA256 of everything before (16)
+//
+// Receivers should drop datagrams whose time is far from their clock, or older than the last accepted one,
+// since a datagram may be replayed by anyone on the network. Sequence numbers restart from 0 with the sender.
+type Datagram struct {
+	Time     uint32
+	Sequence uint32
+	Frames   []Frame
+}
+
+// EncodeDatagram returns datagram with frames signed by secret.
+func EncodeDatagram(secret []byte, datagram Datagram) []byte {
+	buf := make([]byte, datagramHeaderSize, 256)
+	binary.BigEndian.PutUint32(buf, datagram.Time)
+	binary.BigEndian.PutUint32(buf[4:], datagram.Sequence)
+
+	for _, frame := range datagram.Frames {
+		buf = append(buf, Encode(frame)...)
+	}
+
+	return append(buf, tag(secret, buf)...)
+}
+
+// DecodeDatagram verifies signature of datagram and decodes its frames.
+// Frames of unknown types are skipped, so that new frame types can be added without breaking receivers.
+func DecodeDatagram(secret []byte, data []byte) (Datagram, error) {
+	if len(data) < datagramHeaderSize+TagSize {
+		return Datagram{}, ErrDatagram
+	}
+
+	body, signature := data[:len(data)-TagSize], data[len(data)-TagSize:]
+	if !hmac.Equal(tag(secret, body), signature) {
+		return Datagram{}, ErrSignature
+	}
+
+	datagram := Datagram{
+		Time:     binary.BigEndian.Uint32(body),
+		Sequence: binary.BigEndian.Uint32(body[4:]),
+	}
+
+	r := bytes.NewReader(body[datagramHeaderSize:])
+
+	for r.Len() > 0 {
+		frame, err := Decode(r)
+
+		switch {
+		case errors.Is(err, ErrPayload):
+			continue
+		case err != nil:
+			return Datagram{}, fmt.Errorf("binproto: decode datagram frame: %w", err)
+		}
+
+		datagram.Frames = append(datagram.Frames, frame)
+	}
+
+	return datagram, nil
+}
+
+func tag(secret []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+
+	return mac.Sum(nil)[:TagSize]
+}
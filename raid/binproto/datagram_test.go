@@ -0,0 +1,35 @@
+package binproto
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDatagram(t *testing.T) {
+	secret := []byte("secret")
+	datagram := Datagram{Time: 1666077000, Sequence: 3, Frames: []Frame{Ping{Time: 1666077000}, Change{RegionID: 12}}}
+	data := EncodeDatagram(secret, datagram)
+
+	decoded, err := DecodeDatagram(secret, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded, datagram) {
+		t.Errorf("got %#v, want %#v", decoded, datagram)
+	}
+
+	if _, err := DecodeDatagram([]byte("other"), data); !errors.Is(err, ErrSignature) {
+		t.Errorf("got %v, want %v", err, ErrSignature)
+	}
+
+	data[9] ^= 0x01
+	if _, err := DecodeDatagram(secret, data); !errors.Is(err, ErrSignature) {
+		t.Errorf("tampered datagram: got %v, want %v", err, ErrSignature)
+	}
+
+	if _, err := DecodeDatagram(secret, data[:TagSize]); !errors.Is(err, ErrDatagram) {
+		t.Errorf("short datagram: got %v, want %v", err, ErrDatagram)
+	}
+}
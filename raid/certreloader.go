@@ -0,0 +1,180 @@
+package raid
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// How often certificate files are checked for changes.
+const certReloadInterval = 10 * time.Second
+
+// CertReloader serves TLS certificate and CA of client certificates loaded from files,
+// and reloads them when files change. Existing connections are not affected by reloads.
+type CertReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	config       *tls.Config
+	notAfter     time.Time
+	// Modification times of loaded files.
+	modTimes map[string]time.Time
+	mutex    sync.RWMutex
+}
+
+func NewCertReloader(certFile string, keyFile string, clientCAFile string) *CertReloader {
+	certReloader := &CertReloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+
+	if err := certReloader.load(); err != nil {
+		log.Fatalf("certreloader: %s", err)
+	}
+
+	return certReloader
+}
+
+func (c *CertReloader) files() []string {
+	files := []string{c.certFile, c.keyFile}
+	if c.clientCAFile != "" {
+		files = append(files, c.clientCAFile)
+	}
+
+	return files
+}
+
+func (c *CertReloader) load() error {
+	modTimes := map[string]time.Time{}
+
+	for _, name := range c.files() {
+		info, err := os.Stat(name)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", name, err)
+		}
+
+		modTimes[name] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("parse certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	// Client certificates are optional, since devices may authenticate with API keys as well.
+	if c.clientCAFile != "" {
+		data, err := os.ReadFile(c.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("read client CA: %w", err)
+		}
+
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(data) {
+			return errors.New("no certificates found in client CA file")
+		}
+
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.config = config
+	c.notAfter = leaf.NotAfter
+	c.modTimes = modTimes
+
+	return nil
+}
+
+func (c *CertReloader) isModified() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, name := range c.files() {
+		info, err := os.Stat(name)
+		if err != nil {
+			// Files may be missing for a moment while being replaced.
+			continue
+		}
+
+		if !info.ModTime().Equal(c.modTimes[name]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ping fails if the loaded certificate has expired, e.g. if renewed one was not written to files.
+func (c *CertReloader) Ping(ctx context.Context) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if time.Now().After(c.notAfter) {
+		return fmt.Errorf("certreloader: certificate expired at %s", c.notAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// TLSConfig returns config that always uses the latest loaded certificates.
+func (c *CertReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c.mutex.RLock()
+			defer c.mutex.RUnlock()
+
+			return c.config, nil
+		},
+	}
+}
+
+func (c *CertReloader) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("certreloader: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	ticker := time.NewTicker(certReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		if !c.isModified() {
+			continue
+		}
+
+		// Previous certificates are kept if new ones are invalid, e.g. if only one of files was replaced yet.
+		if err := c.load(); err != nil {
+			log.Errorf("certreloader: reload: %v", err)
+
+			continue
+		}
+
+		log.Info("certreloader: reload certificates")
+	}
+}
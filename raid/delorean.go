@@ -3,82 +3,473 @@ package raid
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3" // SQLite3 driver
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 )
 
+// Number of records between checkpoints, which are snapshots used to reconstruct past states
+// without replaying the whole history.
+const checkpointInterval = 500
+
 type Delorean struct {
 	db            *sql.DB
 	addRecordStmt *sql.Stmt
+	registry      *Registry
 	updates       *Topic[Update]
+	// State after checkpointRecord, updated with every new record and saved every checkpointInterval records.
+	checkpoint       *UpdaterSnapshot
+	checkpointRecord Record
+	// Latest date of records applied to checkpoint, which may be later than date of checkpointRecord,
+	// since records from backlog are added after newer ones.
+	checkpointDate time.Time
+	pendingRecords int
 }
 
 type Record struct {
-	ID      int       `json:"id"`
-	Date    time.Time `json:"date"`
-	StateID int       `json:"state_id"`
-	Alert   bool      `json:"alert"`
+	ID         int       `json:"id"`
+	EventID    int64     `json:"event_id"`
+	Date       time.Time `json:"date"`
+	StateID    int       `json:"state_id"`
+	Alert      bool      `json:"alert"`
+	AlertType  AlertType `json:"alert_type"`
+	DistrictID int       `json:"district_id"`
+	MessageID  int64     `json:"message_id"`
+}
+
+// RecordQuery selects records for QueryRecords. Zero values mean no filtering.
+type RecordQuery struct {
+	StateID int
+	// StateIDs limits records to given states, e.g. the ones allowed for an API key.
+	StateIDs  []int
+	From      time.Time
+	To        time.Time
+	Alert     *bool
+	AlertType AlertType
+	AfterID   int
+	Limit     int
 }
 
-func NewDelorean(dbname string, updates *Topic[Update]) *Delorean {
+func NewDelorean(dbname string, registry *Registry, updates *Topic[Update]) *Delorean {
 	db, err := sql.Open("sqlite3", fmt.Sprintf("./data/%s.sqlite", dbname))
 	if err != nil {
 		log.Fatalf("delorean: open DB: %s", err)
 	}
 
-	if _, err := db.Exec(`
-		CREATE TABLE IF NOT EXISTS events (
-			id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
-			date timestamp NOT NULL,
-			state_id integer NOT NULL,
-			alert bool NOT NULL
-		);
-	`); err != nil {
-		log.Fatalf("delorean: execute schema mutation: %s", err)
+	if err := migrate(context.Background(), db, "history"); err != nil {
+		log.Fatalf("delorean: %s", err)
 	}
 
 	addRecordStmt, err := db.Prepare(`
-		INSERT INTO events (date, state_id, alert)
-		VALUES (?, ?, ?)
+		INSERT OR IGNORE INTO events (date, state_id, alert, alert_type, district_id, event_id, message_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
 		log.Fatalf("delorean: prepare add record: %s", err)
 	}
 
-	return &Delorean{db, addRecordStmt, updates}
+	return &Delorean{
+		db:            db,
+		addRecordStmt: addRecordStmt,
+		registry:      registry,
+		updates:       updates,
+	}
 }
 
-func (d *Delorean) addRecord(state State) error {
-	if _, err := d.addRecordStmt.Exec(state.Changed, state.ID, state.Alert); err != nil {
+func (d *Delorean) addRecord(update Update) error {
+	state := update.State
+	record := Record{
+		EventID:   update.EventID,
+		StateID:   state.ID,
+		Alert:     state.HasAlert(update.AlertType),
+		AlertType: update.AlertType,
+		MessageID: update.MessageID,
+	}
+	changed := state.Changed
+
+	if district := update.District; district != nil {
+		changed, record.Alert, record.DistrictID = district.Changed, district.HasAlert(update.AlertType), district.ID
+	}
+
+	if changed != nil {
+		record.Date = *changed
+	}
+
+	timer := prometheus.NewTimer(deloreanInsertDuration)
+	result, err := d.addRecordStmt.Exec(
+		changed, record.StateID, record.Alert, record.AlertType, record.DistrictID, record.EventID, record.MessageID,
+	)
+	timer.ObserveDuration()
+
+	if err != nil {
 		return fmt.Errorf("delorean: execute add record: %w", err)
 	}
 
+	// Messages are processed again when the backlog is fetched, e.g. after the updater state is lost.
+	if affected, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("delorean: get affected rows: %w", err)
+	} else if affected == 0 {
+		log.Debugf("delorean: skip duplicate record for message %d", record.MessageID)
+
+		return nil
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("delorean: get record ID: %w", err)
+	}
+
+	record.ID = int(id)
+
+	if err := applyToIntervals(context.Background(), d.db, record); err != nil {
+		return err
+	}
+
+	return d.advanceCheckpoint(record)
+}
+
+// advanceCheckpoint applies record to the current checkpoint and saves it if enough records were applied.
+func (d *Delorean) advanceCheckpoint(record Record) error {
+	if d.checkpoint == nil {
+		return nil
+	}
+
+	d.checkpoint.ApplyRecord(record)
+	d.checkpointRecord = record
+	d.pendingRecords++
+
+	if record.Date.After(d.checkpointDate) {
+		d.checkpointDate = record.Date
+	}
+
+	if d.pendingRecords < checkpointInterval {
+		return nil
+	}
+
+	data, err := json.Marshal(d.checkpoint)
+	if err != nil {
+		return fmt.Errorf("delorean: encode checkpoint: %w", err)
+	}
+
+	if _, err := d.db.Exec(
+		"INSERT OR REPLACE INTO checkpoints (record_id, date, snapshot) VALUES (?, ?, ?)",
+		record.ID, d.checkpointDate, string(data),
+	); err != nil {
+		return fmt.Errorf("delorean: save checkpoint: %w", err)
+	}
+
+	d.pendingRecords = 0
+
 	return nil
 }
 
-func (d *Delorean) ListRecords() ([]Record, error) {
-	rows, err := d.db.Query("SELECT * FROM events ORDER BY id ASC")
+// loadCheckpoint returns the latest checkpoint whose records are all not newer than at (or the latest one if at is zero),
+// or an empty snapshot if there is none. Date of returned record is the latest date of records in checkpoint.
+func (d *Delorean) loadCheckpoint(ctx context.Context, at time.Time) (*UpdaterSnapshot, Record, error) {
+	snapshot := d.registry.NewSnapshot()
+	record := Record{}
+
+	condition, args := "1", []interface{}{}
+	if !at.IsZero() {
+		condition, args = "julianday(date) <= julianday(?)", append(args, at)
+	}
+
+	var data string
+
+	if err := d.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT record_id, date, snapshot
+		FROM checkpoints
+		WHERE %s
+		ORDER BY record_id DESC
+		LIMIT 1
+	`, condition), args...).Scan(&record.ID, &record.Date, &data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot, record, nil
+		}
+
+		return nil, record, fmt.Errorf("delorean: load checkpoint: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(data), snapshot); err != nil {
+		return nil, record, fmt.Errorf("delorean: decode checkpoint %d: %w", record.ID, err)
+	}
+
+	// Registry might have changed since the checkpoint was saved.
+	d.registry.apply(snapshot)
+
+	return snapshot, record, nil
+}
+
+// catchUpCheckpoints creates checkpoints for records added since the latest checkpoint,
+// e.g. for the history recorded before checkpoints were introduced.
+func (d *Delorean) catchUpCheckpoints(ctx context.Context) error {
+	checkpoint, checkpointRecord, err := d.loadCheckpoint(ctx, time.Time{})
 	if err != nil {
-		return nil, fmt.Errorf("delorean: list records: %w", err)
+		return err
 	}
-	defer rows.Close()
 
+	d.checkpoint, d.checkpointRecord, d.pendingRecords = checkpoint, checkpointRecord, 0
+	d.checkpointDate = checkpointRecord.Date
+
+	for {
+		records := []Record{}
+
+		if err := d.QueryRecords(ctx, RecordQuery{AfterID: d.checkpointRecord.ID, Limit: checkpointInterval}, func(record Record) error {
+			records = append(records, record)
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if err := d.advanceCheckpoint(record); err != nil {
+				return err
+			}
+		}
+
+		if len(records) < checkpointInterval {
+			log.Infof("delorean: checkpoints are up to date at record %d", d.checkpointRecord.ID)
+
+			return nil
+		}
+	}
+}
+
+// StateAt reconstructs states as they were at the given moment.
+func (d *Delorean) StateAt(ctx context.Context, at time.Time) (*UpdaterSnapshot, error) {
+	snapshot, checkpointRecord, err := d.loadCheckpoint(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.queryRecords(
+		ctx, []string{"id > ?", "julianday(date) <= julianday(?)"}, []interface{}{checkpointRecord.ID, at}, 0,
+		func(record Record) error {
+			snapshot.ApplyRecord(record)
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	snapshot.LastUpdate = at
+
+	return snapshot, nil
+}
+
+func (d *Delorean) Ping(ctx context.Context) error {
+	if err := d.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("delorean: ping DB: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Delorean) LastEventID() (int64, error) {
+	var lastEventID int64
+	if err := d.db.QueryRow("SELECT COALESCE(MAX(event_id), 0) FROM events").Scan(&lastEventID); err != nil {
+		return 0, fmt.Errorf("delorean: get last event ID: %w", err)
+	}
+
+	return lastEventID, nil
+}
+
+// RestoreState replaces states in updaterState with the latest recorded ones,
+// e.g. when the persisted state was lost.
+func (d *Delorean) RestoreState(ctx context.Context, updaterState *UpdaterState) error {
+	snapshot, err := d.StateAt(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var lastMessageID, lastEventID int64
+	if err := d.db.QueryRowContext(
+		ctx, "SELECT COALESCE(MAX(message_id), 0), COALESCE(MAX(event_id), 0) FROM events",
+	).Scan(&lastMessageID, &lastEventID); err != nil {
+		return fmt.Errorf("delorean: get last IDs: %w", err)
+	}
+
+	log.Infof("delorean: restore state up to message %d and event %d", lastMessageID, lastEventID)
+
+	return updaterState.Update(func(data *UpdaterSnapshot) error {
+		data.States = snapshot.States
+		data.RegistryVersion = snapshot.RegistryVersion
+		data.LastMessageID = lastMessageID
+		data.LastEventID = lastEventID
+
+		return nil
+	})
+}
+
+func (d *Delorean) ListRecords() ([]Record, error) {
 	result := []Record{}
 
+	if err := d.QueryRecords(context.Background(), RecordQuery{}, func(record Record) error {
+		result = append(result, record)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// QueryRecords calls fn for every record matching query in the order of their IDs without loading them all into memory.
+func (d *Delorean) QueryRecords(ctx context.Context, query RecordQuery, fn func(Record) error) error {
+	conditions := []string{"id > ?"}
+	args := []interface{}{query.AfterID}
+
+	if query.StateID != 0 {
+		conditions = append(conditions, "state_id = ?")
+		args = append(args, query.StateID)
+	}
+
+	if query.StateIDs != nil {
+		condition, stateArgs := stateIDsCondition(query.StateIDs)
+		conditions = append(conditions, condition)
+		args = append(args, stateArgs...)
+	}
+
+	if !query.From.IsZero() {
+		conditions = append(conditions, "julianday(date) >= julianday(?)")
+		args = append(args, query.From)
+	}
+
+	if !query.To.IsZero() {
+		conditions = append(conditions, "julianday(date) < julianday(?)")
+		args = append(args, query.To)
+	}
+
+	if query.Alert != nil {
+		conditions = append(conditions, "alert = ?")
+		args = append(args, *query.Alert)
+	}
+
+	if query.AlertType != "" {
+		conditions = append(conditions, "alert_type = ?")
+		args = append(args, query.AlertType)
+	}
+
+	return d.queryRecords(ctx, conditions, args, query.Limit, fn)
+}
+
+// stateIDsCondition returns SQL condition which matches rows of given states.
+func stateIDsCondition(stateIDs []int) (string, []interface{}) {
+	if len(stateIDs) == 0 {
+		return "0", nil
+	}
+
+	args := make([]interface{}, len(stateIDs))
+	for i, id := range stateIDs {
+		args[i] = id
+	}
+
+	return fmt.Sprintf("state_id IN (%s)", strings.TrimSuffix(strings.Repeat("?,", len(stateIDs)), ",")), args
+}
+
+func (d *Delorean) queryRecords(
+	ctx context.Context, conditions []string, args []interface{}, limit int, fn func(Record) error,
+) error {
+	limitClause := ""
+	if limit > 0 {
+		limitClause = "LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, event_id, date, state_id, alert, alert_type, district_id, message_id
+		FROM events
+		WHERE %s
+		ORDER BY id ASC
+		%s
+	`, strings.Join(conditions, " AND "), limitClause), args...)
+	if err != nil {
+		return fmt.Errorf("delorean: query records: %w", err)
+	}
+	defer rows.Close()
+
 	for rows.Next() {
 		record := Record{}
-		if err := rows.Scan(&record.ID, &record.Date, &record.StateID, &record.Alert); err != nil {
-			return nil, fmt.Errorf("delorean: scan row: %w", err)
+		if err := rows.Scan(
+			&record.ID, &record.EventID, &record.Date, &record.StateID, &record.Alert, &record.AlertType,
+			&record.DistrictID, &record.MessageID,
+		); err != nil {
+			return fmt.Errorf("delorean: scan row: %w", err)
 		}
 
-		result = append(result, record)
+		if err := fn(record); err != nil {
+			return err
+		}
 	}
 
-	return result, nil
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("delorean: iterate rows: %w", err)
+	}
+
+	return nil
+}
+
+// Records are duplicates if they describe the same change of the same region at the same time.
+const duplicatesGroupBy = "date, state_id, district_id, alert_type, alert"
+
+// Deduplicate removes records that repeat earlier ones, e.g. written when the backlog was processed again
+// before records had message IDs. Checkpoints and intervals are cleared and rebuilt on the next start.
+// Returns the number of duplicates found, which are kept if dryRun is true.
+func (d *Delorean) Deduplicate(ctx context.Context, dryRun bool) (int64, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("delorean: begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var count int64
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT COUNT(*) FROM events WHERE id NOT IN (SELECT MIN(id) FROM events GROUP BY %s)
+	`, duplicatesGroupBy)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("delorean: count duplicates: %w", err)
+	}
+
+	if dryRun || count == 0 {
+		return count, nil
+	}
+
+	// The earliest record of each group is kept, but it takes over the message ID of its duplicates if it has none.
+	for _, query := range []string{
+		fmt.Sprintf(`
+			CREATE TEMP TABLE duplicates AS
+			SELECT MIN(id) AS keep_id, MAX(message_id) AS message_id FROM events GROUP BY %s HAVING COUNT(*) > 1
+		`, duplicatesGroupBy),
+		fmt.Sprintf("DELETE FROM events WHERE id NOT IN (SELECT MIN(id) FROM events GROUP BY %s)", duplicatesGroupBy),
+		`
+			UPDATE events SET message_id = (SELECT message_id FROM duplicates WHERE keep_id = events.id)
+			WHERE id IN (SELECT keep_id FROM duplicates)
+		`,
+		"DROP TABLE duplicates",
+		"DELETE FROM checkpoints",
+		"DELETE FROM intervals",
+	} {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return 0, fmt.Errorf("delorean: remove duplicates: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("delorean: commit: %w", err)
+	}
+
+	return count, nil
 }
 
 func (d *Delorean) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
@@ -87,11 +478,23 @@ func (d *Delorean) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error
 	defer wg.Done()
 	wg.Add(1)
 
-	events := d.updates.Subscribe("delorean", func(u Update) bool {
+	events := d.updates.Subscribe("delorean", OverflowBlock, func(u Update) bool {
 		return true
 	})
 	defer d.updates.Unsubscribe(events)
 
+	if err := d.catchUpCheckpoints(ctx); err != nil {
+		errch <- fmt.Errorf("delorean: catch up checkpoints: %w", err)
+
+		return
+	}
+
+	if err := d.catchUpIntervals(ctx); err != nil {
+		errch <- fmt.Errorf("delorean: catch up intervals: %w", err)
+
+		return
+	}
+
 	for {
 		select {
 		case event, ok := <-events:
@@ -99,7 +502,7 @@ func (d *Delorean) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error
 				return
 			}
 
-			if err := d.addRecord(event.State); err != nil {
+			if err := d.addRecord(event); err != nil {
 				errch <- fmt.Errorf("delorean: add record: %w", err)
 
 				return
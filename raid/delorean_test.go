@@ -0,0 +1,228 @@
+package raid
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+// chdirTemp runs the test in a temporary directory with empty data directory, where databases are created.
+func chdirTemp(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.Mkdir(dir+"/data", 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+func testUpdate(messageID int64, stateID int, alert bool, date time.Time) Update {
+	state := State{ID: stateID, Changed: &date}
+	state.SetAlert(AlertAirRaid, alert)
+
+	return Update{EventID: messageID, MessageID: messageID, AlertType: AlertAirRaid, State: state}
+}
+
+func TestDeloreanStateAtWithBacklogRecords(t *testing.T) {
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	delorean := NewDelorean("history", registry, NewTopic[Update]())
+
+	if err := delorean.catchUpCheckpoints(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	// Records of state 12 are added first, and the last record which completes the checkpoint is an older one
+	// of state 14, e.g. from backlog fetched later.
+	for i := 1; i < checkpointInterval; i++ {
+		date := start.Add(time.Hour + time.Duration(i)*time.Second)
+		if err := delorean.addRecord(testUpdate(int64(i), 12, i%2 == 1, date)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := delorean.addRecord(testUpdate(checkpointInterval, 14, true, start)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		at      time.Time
+		stateID int
+		alert   bool
+	}{
+		{start.Add(time.Minute), 12, false},
+		{start.Add(time.Minute), 14, true},
+		{start.Add(time.Hour + time.Second), 12, true},
+		{start.Add(time.Hour + 2*time.Second), 12, false},
+		{start.Add(2 * time.Hour), 12, true},
+	}
+
+	for _, test := range tests {
+		snapshot, err := delorean.StateAt(ctx, test.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if alert := snapshot.FindState(test.stateID).Alert; alert != test.alert {
+			t.Errorf("state %d at %s: got alert %v, want %v", test.stateID, test.at, alert, test.alert)
+		}
+	}
+}
+
+func TestDeloreanRestoreState(t *testing.T) {
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	delorean := NewDelorean("history", registry, NewTopic[Update]())
+
+	if err := delorean.catchUpCheckpoints(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now().Add(-time.Hour)
+
+	for i, update := range []Update{
+		testUpdate(1001, 12, true, start),
+		testUpdate(1002, 14, true, start.Add(time.Minute)),
+		testUpdate(1003, 12, false, start.Add(2*time.Minute)),
+	} {
+		update.EventID = int64(i + 1)
+		if err := delorean.addRecord(update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	updaterState := NewUpdaterState()
+	if err := delorean.RestoreState(ctx, updaterState); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := updaterState.Snapshot()
+
+	if snapshot.LastMessageID != 1003 || snapshot.LastEventID != 3 {
+		t.Errorf("got last message ID %d and event ID %d, want 1003 and 3", snapshot.LastMessageID, snapshot.LastEventID)
+	}
+
+	if want := len(registry.NewSnapshot().States); len(snapshot.States) != want {
+		t.Errorf("got %d states, want %d", len(snapshot.States), want)
+	}
+
+	for stateID, alert := range map[int]bool{12: false, 14: true, 9: false} {
+		if got := snapshot.FindState(stateID).Alert; got != alert {
+			t.Errorf("state %d: got alert %v, want %v", stateID, got, alert)
+		}
+	}
+}
+
+func TestDeloreanSkipsDuplicateMessages(t *testing.T) {
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delorean := NewDelorean("history", registry, NewTopic[Update]())
+	date := time.Date(2022, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	// Records with the same message ID are only added once, e.g. when the backlog is processed again,
+	// but records without message IDs are never considered duplicates.
+	for _, update := range []Update{
+		testUpdate(1001, 12, true, date),
+		testUpdate(1001, 12, true, date),
+		testUpdate(0, 14, true, date),
+		testUpdate(0, 14, true, date),
+	} {
+		if err := delorean.addRecord(update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := delorean.ListRecords()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 3 {
+		t.Errorf("got %d records, want 3", len(records))
+	}
+}
+
+func TestDeloreanDeduplicate(t *testing.T) {
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	delorean := NewDelorean("history", registry, NewTopic[Update]())
+	date := time.Date(2022, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	for _, update := range []Update{
+		testUpdate(0, 12, true, date),
+		testUpdate(0, 12, true, date),
+		testUpdate(1003, 12, true, date),
+		testUpdate(0, 12, false, date.Add(time.Hour)),
+	} {
+		if err := delorean.addRecord(update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if count, err := delorean.Deduplicate(ctx, true); err != nil || count != 2 {
+		t.Fatalf("got %d duplicates and error %v in dry run, want 2", count, err)
+	}
+
+	if records, _ := delorean.ListRecords(); len(records) != 4 {
+		t.Fatalf("got %d records after dry run, want 4", len(records))
+	}
+
+	if count, err := delorean.Deduplicate(ctx, false); err != nil || count != 2 {
+		t.Fatalf("got %d duplicates and error %v, want 2", count, err)
+	}
+
+	records, err := delorean.ListRecords()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+
+	// The earliest record is kept with the message ID of its duplicate.
+	if records[0].ID != 1 || records[0].MessageID != 1003 || records[1].Alert {
+		t.Errorf("got records %+v", records)
+	}
+
+	if count, err := delorean.Deduplicate(ctx, false); err != nil || count != 0 {
+		t.Errorf("got %d duplicates and error %v after deduplication, want 0", count, err)
+	}
+}
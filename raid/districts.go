@@ -0,0 +1,52 @@
+package raid
+
+import (
+	"time"
+)
+
+type District struct {
+	ID      int         `json:"id"`
+	Name    string      `json:"name"`
+	NameEn  string      `json:"name_en"`
+	Alert   bool        `json:"alert"`
+	Alerts  []AlertType `json:"alerts"`
+	Changed *time.Time  `json:"changed"`
+}
+
+func (d District) HasAlert(alertType AlertType) bool {
+	return hasAlert(d.Alerts, alertType)
+}
+
+func (d *District) SetAlert(alertType AlertType, on bool) {
+	d.Alerts = setAlert(d.Alerts, alertType, on)
+
+	if alertType == AlertAirRaid {
+		d.Alert = on
+	}
+}
+
+func hasAlert(alerts []AlertType, alertType AlertType) bool {
+	for _, other := range alerts {
+		if other == alertType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setAlert(alerts []AlertType, alertType AlertType, on bool) []AlertType {
+	result := []AlertType{}
+
+	for _, other := range alerts {
+		if other != alertType {
+			result = append(result, other)
+		}
+	}
+
+	if on {
+		result = append(result, alertType)
+	}
+
+	return result
+}
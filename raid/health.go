@@ -0,0 +1,145 @@
+package raid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const healthCheckTimeout = 5 * time.Second
+
+type HealthCheck func(ctx context.Context) error
+
+type healthCheck struct {
+	name string
+	// Liveness checks fail only if the app is broken and should be restarted,
+	// other checks only make the app not ready to serve requests.
+	liveness bool
+	check    HealthCheck
+}
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type HealthResponse struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+// Health collects checks of app components for /healthz and /readyz endpoints.
+type Health struct {
+	checks []healthCheck
+	mutex  sync.Mutex
+}
+
+func NewHealth() *Health {
+	return &Health{}
+}
+
+func (h *Health) Add(name string, liveness bool, check HealthCheck) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.checks = append(h.checks, healthCheck{name, liveness, check})
+}
+
+// Check runs liveness checks, or all checks if readiness is true.
+func (h *Health) Check(ctx context.Context, readiness bool) HealthResponse {
+	h.mutex.Lock()
+	checks := append([]healthCheck{}, h.checks...)
+	h.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	response := HealthResponse{"ok", map[string]ComponentStatus{}}
+
+	for _, check := range checks {
+		if !check.liveness && !readiness {
+			continue
+		}
+
+		if err := check.check(ctx); err != nil {
+			response.Status = "fail"
+			response.Components[check.name] = ComponentStatus{"fail", err.Error()}
+		} else {
+			response.Components[check.name] = ComponentStatus{"ok", ""}
+		}
+	}
+
+	return response
+}
+
+func (h *Health) Handler(readiness bool) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		response := h.Check(r.Context(), readiness)
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Header().Add("Cache-Control", "no-cache")
+
+		if response.Status == "ok" {
+			rw.WriteHeader(200)
+		} else {
+			rw.WriteHeader(503)
+		}
+
+		enc := json.NewEncoder(rw)
+		_ = enc.Encode(response)
+	}
+}
+
+// activity records when a component was last active, so that health checks can find stuck or failing loops.
+type activity struct {
+	at    time.Time
+	mutex sync.Mutex
+}
+
+func (a *activity) mark() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.at = time.Now()
+}
+
+// check fails if there was no activity for longer than maxAge.
+func (a *activity) check(what string, maxAge time.Duration) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.at.IsZero() {
+		return fmt.Errorf("no %s yet", what)
+	}
+
+	if age := time.Since(a.at); age > maxAge {
+		return fmt.Errorf("last %s was %s ago", what, age.Round(time.Second))
+	}
+
+	return nil
+}
+
+// Stale returns true if states were not fetched from source for longer than threshold.
+func (d *UpdaterSnapshot) Stale(threshold time.Duration) bool {
+	return time.Since(d.LastUpdate) > threshold
+}
+
+// FreshnessCheck fails if states were not fetched from source for longer than threshold.
+func FreshnessCheck(updaterState *UpdaterState, threshold time.Duration) HealthCheck {
+	return func(ctx context.Context) error {
+		snapshot := updaterState.Snapshot()
+		if snapshot.LastUpdate.IsZero() {
+			return errors.New("no successful fetch yet")
+		}
+
+		if snapshot.Stale(threshold) {
+			return fmt.Errorf("last successful fetch was %s ago", time.Since(snapshot.LastUpdate).Round(time.Second))
+		}
+
+		return nil
+	}
+}
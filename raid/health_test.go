@@ -0,0 +1,79 @@
+package raid
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHealthCheck(t *testing.T) {
+	health := NewHealth()
+	health.Add("live", true, func(context.Context) error { return nil })
+	health.Add("ready", false, func(context.Context) error { return errors.New("not ready") })
+
+	ctx := context.Background()
+
+	// Readiness checks don't affect liveness.
+	if response := health.Check(ctx, false); response.Status != "ok" || len(response.Components) != 1 {
+		t.Errorf("liveness: got %+v", response)
+	}
+
+	response := health.Check(ctx, true)
+	if response.Status != "fail" || response.Components["ready"] != (ComponentStatus{"fail", "not ready"}) {
+		t.Errorf("readiness: got %+v", response)
+	}
+}
+
+func TestActivity(t *testing.T) {
+	a := &activity{}
+
+	if err := a.check("send", time.Minute); err == nil || err.Error() != "no send yet" {
+		t.Errorf("before activity: got %v", err)
+	}
+
+	a.mark()
+
+	if err := a.check("send", time.Minute); err != nil {
+		t.Errorf("after activity: got %v", err)
+	}
+
+	a.at = a.at.Add(-2 * time.Minute)
+
+	if err := a.check("send", time.Minute); err == nil || err.Error() != "last send was 2m0s ago" {
+		t.Errorf("after stall: got %v", err)
+	}
+}
+
+func TestTCPServerPing(t *testing.T) {
+	chdirTemp(t)
+
+	keys := NewKeyStore("keys", nil)
+	tcpServer := NewTCPServer(0, 0, nil, keys, NewUsage("usage", keys), NewUpdaterState(), NewTopic[Update](), time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	errch := make(chan error, 1)
+
+	if err := tcpServer.Ping(ctx); err == nil {
+		t.Error("server is healthy before it's started")
+	}
+
+	go tcpServer.Run(ctx, wg, errch)
+
+	deadline := time.Now().Add(time.Second)
+	for tcpServer.Ping(ctx) != nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := tcpServer.Ping(ctx); err != nil {
+		t.Errorf("started server: %v", err)
+	}
+
+	cancel()
+	wg.Wait()
+
+	if err := tcpServer.Ping(ctx); err == nil {
+		t.Error("server is healthy after it's stopped")
+	}
+}
@@ -0,0 +1,240 @@
+package raid
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RegionStats describes alerts of a single type in a region over a period.
+// Durations are clipped to the period, alerts that are still active last until now.
+type RegionStats struct {
+	StateID      int       `json:"state_id"`
+	DistrictID   int       `json:"district_id"`
+	AlertType    AlertType `json:"alert_type"`
+	Count        int       `json:"count"`
+	TotalHours   float64   `json:"total_hours"`
+	MedianHours  float64   `json:"median_hours"`
+	LongestHours float64   `json:"longest_hours"`
+	LongestStart time.Time `json:"longest_start"`
+}
+
+type StatsQuery struct {
+	From    time.Time
+	To      time.Time
+	StateID int
+	// StateIDs limits stats to given states, e.g. the ones allowed for an API key.
+	StateIDs  []int
+	AlertType AlertType
+}
+
+// Number of records applied to intervals in a single transaction while catching up.
+const intervalsBatchSize = 1000
+
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// applyToIntervals opens or closes an interval for the record's region and alert type.
+// It is idempotent, so records can be applied again safely.
+func applyToIntervals(ctx context.Context, db sqlExecer, record Record) error {
+	if record.Alert {
+		if _, err := db.ExecContext(ctx, `
+			INSERT OR IGNORE INTO intervals (state_id, district_id, alert_type, started_at, start_record_id)
+			VALUES (?, ?, ?, ?, ?)
+		`, record.StateID, record.DistrictID, record.AlertType, record.Date, record.ID); err != nil {
+			return fmt.Errorf("delorean: open interval: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		UPDATE intervals
+		SET ended_at = ?, duration = ROUND((julianday(?) - julianday(started_at)) * 86400, 3), end_record_id = ?
+		WHERE state_id = ? AND district_id = ? AND alert_type = ? AND ended_at IS NULL
+	`, record.Date, record.Date, record.ID, record.StateID, record.DistrictID, record.AlertType); err != nil {
+		return fmt.Errorf("delorean: close interval: %w", err)
+	}
+
+	return nil
+}
+
+// catchUpIntervals applies records that were added since the last interval change,
+// e.g. for the history recorded before intervals were introduced.
+func (d *Delorean) catchUpIntervals(ctx context.Context) error {
+	var lastRecordID int
+	if err := d.db.QueryRowContext(ctx, `
+		SELECT MAX(COALESCE(MAX(start_record_id), 0), COALESCE(MAX(end_record_id), 0)) FROM intervals
+	`).Scan(&lastRecordID); err != nil {
+		return fmt.Errorf("delorean: get last interval record: %w", err)
+	}
+
+	for {
+		records := []Record{}
+
+		if err := d.QueryRecords(ctx, RecordQuery{AfterID: lastRecordID, Limit: intervalsBatchSize}, func(record Record) error {
+			records = append(records, record)
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := d.applyToIntervalsTx(ctx, records); err != nil {
+			return err
+		}
+
+		if len(records) > 0 {
+			lastRecordID = records[len(records)-1].ID
+		}
+
+		if len(records) < intervalsBatchSize {
+			log.Infof("delorean: intervals are up to date at record %d", lastRecordID)
+
+			return nil
+		}
+	}
+}
+
+func (d *Delorean) applyToIntervalsTx(ctx context.Context, records []Record) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("delorean: begin transaction: %w", err)
+	}
+
+	for _, record := range records {
+		if err := applyToIntervals(ctx, tx, record); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("delorean: commit intervals: %w", err)
+	}
+
+	return nil
+}
+
+// Stats aggregates alert intervals which overlap the query period by region and alert type.
+func (d *Delorean) Stats(ctx context.Context, query StatsQuery) ([]RegionStats, error) {
+	conditions := []string{
+		"julianday(started_at) < julianday(?)",
+		"(ended_at IS NULL OR julianday(ended_at) > julianday(?))",
+	}
+	args := []interface{}{query.To, query.From}
+
+	if query.StateID != 0 {
+		conditions = append(conditions, "state_id = ?")
+		args = append(args, query.StateID)
+	}
+
+	if query.StateIDs != nil {
+		condition, stateArgs := stateIDsCondition(query.StateIDs)
+		conditions = append(conditions, condition)
+		args = append(args, stateArgs...)
+	}
+
+	if query.AlertType != "" {
+		conditions = append(conditions, "alert_type = ?")
+		args = append(args, query.AlertType)
+	}
+
+	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT state_id, district_id, alert_type, started_at, ended_at
+		FROM intervals
+		WHERE %s
+		ORDER BY state_id, district_id, alert_type, id
+	`, strings.Join(conditions, " AND ")), args...)
+	if err != nil {
+		return nil, fmt.Errorf("delorean: query intervals: %w", err)
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	result := []RegionStats{}
+	durations := []time.Duration{}
+
+	finish := func() {
+		if len(durations) == 0 {
+			return
+		}
+
+		stats := &result[len(result)-1]
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		median := durations[len(durations)/2]
+		if len(durations)%2 == 0 {
+			median = (durations[len(durations)/2-1] + median) / 2
+		}
+
+		stats.MedianHours = median.Hours()
+		durations = durations[:0]
+	}
+
+	for rows.Next() {
+		var (
+			stateID, districtID int
+			alertType           AlertType
+			start               time.Time
+			end                 sql.NullTime
+		)
+
+		if err := rows.Scan(&stateID, &districtID, &alertType, &start, &end); err != nil {
+			return nil, fmt.Errorf("delorean: scan interval: %w", err)
+		}
+
+		if !end.Valid {
+			end.Time = now
+		}
+
+		clippedStart, clippedEnd := start, end.Time
+		if clippedStart.Before(query.From) {
+			clippedStart = query.From
+		}
+
+		if clippedEnd.After(query.To) {
+			clippedEnd = query.To
+		}
+
+		duration := clippedEnd.Sub(clippedStart)
+		if duration <= 0 {
+			continue
+		}
+
+		if len(result) == 0 || !result[len(result)-1].matches(stateID, districtID, alertType) {
+			finish()
+
+			result = append(result, RegionStats{StateID: stateID, DistrictID: districtID, AlertType: alertType})
+		}
+
+		stats := &result[len(result)-1]
+		stats.Count++
+		stats.TotalHours += duration.Hours()
+
+		if duration.Hours() > stats.LongestHours {
+			stats.LongestHours, stats.LongestStart = duration.Hours(), start
+		}
+
+		durations = append(durations, duration)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("delorean: iterate intervals: %w", err)
+	}
+
+	finish()
+
+	return result, nil
+}
+
+func (s RegionStats) matches(stateID int, districtID int, alertType AlertType) bool {
+	return s.StateID == stateID && s.DistrictID == districtID && s.AlertType == alertType
+}
@@ -0,0 +1,99 @@
+package raid
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDeloreanStats(t *testing.T) {
+	chdirTemp(t)
+
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	delorean := NewDelorean("history", registry, NewTopic[Update]())
+
+	start := time.Now().Truncate(time.Hour).Add(-48 * time.Hour)
+	at := func(hours float64) time.Time {
+		return start.Add(time.Duration(hours * float64(time.Hour)))
+	}
+
+	messageID := int64(0)
+	add := func(stateID int, alert bool, hours float64) {
+		t.Helper()
+
+		messageID++
+		if err := delorean.addRecord(testUpdate(messageID, stateID, alert, at(hours))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	add(12, true, 8)
+	add(12, false, 10)
+	add(12, true, 11)
+	add(12, false, 11.5)
+	add(14, true, 11.5)
+	add(14, false, 12.5)
+	add(12, true, 12)
+	add(12, false, 14)
+	// Still active.
+	add(12, true, 15)
+
+	assertStats := func(query StatsQuery, want []RegionStats) {
+		t.Helper()
+
+		got, err := delorean.Stats(ctx, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("got %d stats, want %d: %+v", len(got), len(want), got)
+		}
+
+		for i := range want {
+			g, w := got[i], want[i]
+			if !g.matches(w.StateID, w.DistrictID, w.AlertType) || g.Count != w.Count ||
+				math.Abs(g.TotalHours-w.TotalHours) > 0.01 || math.Abs(g.MedianHours-w.MedianHours) > 0.01 ||
+				math.Abs(g.LongestHours-w.LongestHours) > 0.01 || !g.LongestStart.Equal(w.LongestStart) {
+				t.Errorf("got stats %+v, want %+v", g, w)
+			}
+		}
+	}
+
+	// Intervals are clipped to the period: 1h of the first one, and 1h of the active one.
+	assertStats(StatsQuery{From: at(9), To: at(16)}, []RegionStats{
+		{StateID: 12, AlertType: AlertAirRaid, Count: 4, TotalHours: 4.5, MedianHours: 1, LongestHours: 2, LongestStart: at(12)},
+		{StateID: 14, AlertType: AlertAirRaid, Count: 1, TotalHours: 1, MedianHours: 1, LongestHours: 1, LongestStart: at(11.5)},
+	})
+
+	// Active alert lasts until now.
+	now := time.Since(at(15)).Hours()
+	assertStats(StatsQuery{From: at(12), To: time.Now().Add(time.Hour), StateID: 12}, []RegionStats{
+		{StateID: 12, AlertType: AlertAirRaid, Count: 2, TotalHours: 2 + now, MedianHours: (2 + now) / 2, LongestHours: now, LongestStart: at(15)},
+	})
+
+	assertStats(StatsQuery{From: at(9), To: at(16), StateIDs: []int{14}}, []RegionStats{
+		{StateID: 14, AlertType: AlertAirRaid, Count: 1, TotalHours: 1, MedianHours: 1, LongestHours: 1, LongestStart: at(11.5)},
+	})
+
+	assertStats(StatsQuery{From: at(9), To: at(16), AlertType: AlertArtillery}, []RegionStats{})
+
+	// Intervals are rebuilt from records, e.g. for the history recorded before they were introduced.
+	if _, err := delorean.db.Exec("DELETE FROM intervals"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := delorean.catchUpIntervals(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	assertStats(StatsQuery{From: at(9), To: at(16), StateID: 14}, []RegionStats{
+		{StateID: 14, AlertType: AlertAirRaid, Count: 1, TotalHours: 1, MedianHours: 1, LongestHours: 1, LongestStart: at(11.5)},
+	})
+}
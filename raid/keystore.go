@@ -0,0 +1,507 @@
+package raid
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Number of random bytes in generated API keys.
+	apiKeySize = 16
+	// How often to check if keys were changed by another process, e.g. by "raid keys" command.
+	keyStorePollInterval = time.Second
+)
+
+var ErrKeyNotFound = errors.New("key not found")
+
+// APIKey describes an API key. Only hashes of keys are stored, so the key itself is shown once when it's created.
+type APIKey struct {
+	ID int `json:"id"`
+	// Hash identifies the key in usage stats.
+	Hash      string     `json:"-"`
+	Owner     string     `json:"owner"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Enabled   bool       `json:"enabled"`
+	// IDs of states that the key has access to, empty if all.
+	Regions []int `json:"regions"`
+	// Rate quota of the key, 0 if default.
+	RatePerSec int `json:"rate_per_sec"`
+	RateBurst  int `json:"rate_burst"`
+}
+
+// KeyUpdate describes changes of an API key, nil fields are left unchanged.
+type KeyUpdate struct {
+	Owner   *string
+	Enabled *bool
+	// ExpiresAt is only changed if SetExpiresAt is true, nil ExpiresAt means the key never expires.
+	SetExpiresAt bool
+	ExpiresAt    *time.Time
+	Regions      *[]int
+	RatePerSec   *int
+	RateBurst    *int
+}
+
+// IsActive returns true if the key is enabled and not expired.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.Enabled && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// IsRestricted returns true if the key has access to some states only.
+func (k *APIKey) IsRestricted() bool {
+	return len(k.Regions) > 0
+}
+
+// Allows returns true if the key has access to the state.
+func (k *APIKey) Allows(stateID int) bool {
+	if !k.IsRestricted() {
+		return true
+	}
+
+	for _, id := range k.Regions {
+		if id == stateID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KeyStore keeps API keys in SQLite along with static keys from settings, which are not restricted in any way.
+// Keys are cached in memory and reloaded as soon as they are changed, even by another process.
+type KeyStore struct {
+	db         *sql.DB
+	staticKeys map[string]*APIKey
+	// Keys by their hashes.
+	keys map[string]*APIKey
+	// changed is closed and replaced on every reload.
+	changed chan struct{}
+	mutex   sync.RWMutex
+}
+
+func NewKeyStore(dbname string, staticKeys []string) *KeyStore {
+	// Keys may be changed by several processes at once, so wait for locks instead of failing.
+	db, err := sql.Open("sqlite3", fmt.Sprintf("./data/%s.sqlite?_busy_timeout=5000", dbname))
+	if err != nil {
+		log.Fatalf("keystore: open DB: %s", err)
+	}
+
+	if err := migrate(context.Background(), db, "keys"); err != nil {
+		log.Fatalf("keystore: %s", err)
+	}
+
+	staticKeysMap := make(map[string]*APIKey)
+	for _, key := range staticKeys {
+		staticKeysMap[key] = &APIKey{Hash: hashAPIKey(key), Owner: "settings", Enabled: true}
+	}
+
+	keyStore := &KeyStore{
+		db:         db,
+		staticKeys: staticKeysMap,
+		keys:       map[string]*APIKey{},
+		changed:    make(chan struct{}),
+	}
+
+	if err := keyStore.Reload(context.Background()); err != nil {
+		log.Fatalf("keystore: %s", err)
+	}
+
+	return keyStore
+}
+
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// Lookup returns the key if it's known and active, or nil otherwise.
+func (s *KeyStore) Lookup(key string) *APIKey {
+	if key == "" {
+		return nil
+	}
+
+	s.mutex.RLock()
+	apiKey, ok := s.staticKeys[key]
+
+	if !ok {
+		apiKey, ok = s.keys[hashAPIKey(key)]
+	}
+	s.mutex.RUnlock()
+
+	if !ok || !apiKey.IsActive(time.Now()) {
+		return nil
+	}
+
+	return apiKey
+}
+
+// LookupByID returns the key stored in DB with given ID if it's active, or nil otherwise.
+func (s *KeyStore) LookupByID(id int) *APIKey {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, apiKey := range s.keys {
+		if apiKey.ID == id && apiKey.IsActive(time.Now()) {
+			return apiKey
+		}
+	}
+
+	return nil
+}
+
+// FindByHash returns the key with given hash, even if it's inactive, or nil if there is no such key.
+func (s *KeyStore) FindByHash(hash string) *APIKey {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if apiKey, ok := s.keys[hash]; ok {
+		return apiKey
+	}
+
+	for _, apiKey := range s.staticKeys {
+		if apiKey.Hash == hash {
+			return apiKey
+		}
+	}
+
+	return nil
+}
+
+// IsCurrent returns true if the key is still active and was not changed since apiKey was looked up.
+// Long-lived connections use it to drop clients whose keys were revoked or restricted.
+func (s *KeyStore) IsCurrent(key string, apiKey *APIKey) bool {
+	current := s.Lookup(key)
+
+	return current != nil && current.UpdatedAt.Equal(apiKey.UpdatedAt)
+}
+
+// Changed returns a channel that is closed when keys are reloaded.
+func (s *KeyStore) Changed() <-chan struct{} {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.changed
+}
+
+// Reload loads keys from DB.
+func (s *KeyStore) Reload(ctx context.Context) error {
+	keys := map[string]*APIKey{}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT key_hash, id, owner, created_at, updated_at, expires_at, enabled, regions, rate_per_sec, rate_burst
+		FROM api_keys
+	`)
+	if err != nil {
+		return fmt.Errorf("keystore: query keys: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hash string
+
+		apiKey, err := scanAPIKey(rows, &hash)
+		if err != nil {
+			return err
+		}
+
+		apiKey.Hash = hash
+		keys[hash] = apiKey
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("keystore: read keys: %w", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.keys = keys
+	close(s.changed)
+	s.changed = make(chan struct{})
+
+	log.Debugf("keystore: load %d keys", len(keys))
+
+	return nil
+}
+
+func scanAPIKey(row interface{ Scan(...interface{}) error }, dest ...interface{}) (*APIKey, error) {
+	apiKey := &APIKey{}
+
+	var (
+		expiresAt sql.NullTime
+		regions   string
+	)
+
+	if err := row.Scan(append(dest,
+		&apiKey.ID, &apiKey.Owner, &apiKey.CreatedAt, &apiKey.UpdatedAt, &expiresAt, &apiKey.Enabled, &regions,
+		&apiKey.RatePerSec, &apiKey.RateBurst,
+	)...); err != nil {
+		return nil, fmt.Errorf("keystore: scan key: %w", err)
+	}
+
+	if expiresAt.Valid {
+		apiKey.ExpiresAt = &expiresAt.Time
+	}
+
+	regionIDs, err := parseRegions(regions)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: invalid regions of key %d: %w", apiKey.ID, err)
+	}
+
+	apiKey.Regions = regionIDs
+
+	return apiKey, nil
+}
+
+// parseRegions parses region IDs stored by formatRegions.
+func parseRegions(value string) ([]int, error) {
+	result := []int{}
+
+	for _, idStr := range strings.Split(value, ",") {
+		if idStr == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("parse region ID: %w", err)
+		}
+
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+func formatRegions(regions []int) string {
+	sorted := append([]int{}, regions...)
+	sort.Ints(sorted)
+
+	values := make([]string, len(sorted))
+	for i, id := range sorted {
+		values[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(values, ",")
+}
+
+// List returns all keys stored in DB, including inactive ones.
+func (s *KeyStore) List(ctx context.Context) ([]APIKey, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, owner, created_at, updated_at, expires_at, enabled, regions, rate_per_sec, rate_burst
+		FROM api_keys
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: query keys: %w", err)
+	}
+	defer rows.Close()
+
+	result := []APIKey{}
+
+	for rows.Next() {
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, *apiKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("keystore: read keys: %w", err)
+	}
+
+	return result, nil
+}
+
+// Get returns a key stored in DB by its ID.
+func (s *KeyStore) Get(ctx context.Context, id int) (APIKey, error) {
+	apiKey, err := scanAPIKey(s.db.QueryRowContext(ctx, `
+		SELECT id, owner, created_at, updated_at, expires_at, enabled, regions, rate_per_sec, rate_burst
+		FROM api_keys
+		WHERE id = ?
+	`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return APIKey{}, fmt.Errorf("keystore: get key %d: %w", id, ErrKeyNotFound)
+	}
+
+	if err != nil {
+		return APIKey{}, err
+	}
+
+	return *apiKey, nil
+}
+
+// Create generates a new key with given properties and returns it along with the key itself.
+func (s *KeyStore) Create(ctx context.Context, apiKey APIKey) (APIKey, string, error) {
+	buf := make([]byte, apiKeySize)
+	if _, err := rand.Read(buf); err != nil {
+		return APIKey{}, "", fmt.Errorf("keystore: generate key: %w", err)
+	}
+
+	key := hex.EncodeToString(buf)
+	now := time.Now()
+
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO api_keys (key_hash, owner, created_at, updated_at, expires_at, enabled, regions, rate_per_sec, rate_burst)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, hashAPIKey(key), apiKey.Owner, now, now, apiKey.ExpiresAt, apiKey.Enabled, formatRegions(apiKey.Regions),
+		apiKey.RatePerSec, apiKey.RateBurst)
+	if err != nil {
+		return APIKey{}, "", fmt.Errorf("keystore: insert key: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return APIKey{}, "", fmt.Errorf("keystore: get key ID: %w", err)
+	}
+
+	if err := s.Reload(ctx); err != nil {
+		return APIKey{}, "", err
+	}
+
+	apiKey, err = s.Get(ctx, int(id))
+
+	return apiKey, key, err
+}
+
+// Update changes properties of a key.
+func (s *KeyStore) Update(ctx context.Context, id int, update KeyUpdate) (APIKey, error) {
+	assignments := []string{"updated_at = ?"}
+	args := []interface{}{time.Now()}
+
+	if update.Owner != nil {
+		assignments = append(assignments, "owner = ?")
+		args = append(args, *update.Owner)
+	}
+
+	if update.Enabled != nil {
+		assignments = append(assignments, "enabled = ?")
+		args = append(args, *update.Enabled)
+	}
+
+	if update.SetExpiresAt {
+		assignments = append(assignments, "expires_at = ?")
+		args = append(args, update.ExpiresAt)
+	}
+
+	if update.Regions != nil {
+		assignments = append(assignments, "regions = ?")
+		args = append(args, formatRegions(*update.Regions))
+	}
+
+	if update.RatePerSec != nil {
+		assignments = append(assignments, "rate_per_sec = ?")
+		args = append(args, *update.RatePerSec)
+	}
+
+	if update.RateBurst != nil {
+		assignments = append(assignments, "rate_burst = ?")
+		args = append(args, *update.RateBurst)
+	}
+
+	result, err := s.db.ExecContext(
+		ctx, fmt.Sprintf("UPDATE api_keys SET %s WHERE id = ?", strings.Join(assignments, ", ")), append(args, id)...,
+	)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("keystore: update key %d: %w", id, err)
+	}
+
+	if err := s.checkAffected(result, id); err != nil {
+		return APIKey{}, err
+	}
+
+	if err := s.Reload(ctx); err != nil {
+		return APIKey{}, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete removes a key. Disabling keys should be preferred since it can be undone.
+func (s *KeyStore) Delete(ctx context.Context, id int) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM api_keys WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("keystore: delete key %d: %w", id, err)
+	}
+
+	if err := s.checkAffected(result, id); err != nil {
+		return err
+	}
+
+	return s.Reload(ctx)
+}
+
+func (s *KeyStore) checkAffected(result sql.Result, id int) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("keystore: get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("keystore: key %d: %w", id, ErrKeyNotFound)
+	}
+
+	return nil
+}
+
+// Run reloads keys when they are changed by another process.
+func (s *KeyStore) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("keystore: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	// Data version is only changed by commits of other connections, so the same one has to be used every time.
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		errch <- fmt.Errorf("keystore: get connection: %w", err)
+
+		return
+	}
+	defer conn.Close()
+
+	lastVersion := 0
+
+	ticker := time.NewTicker(keyStorePollInterval)
+	defer ticker.Stop()
+
+	for {
+		var version int
+		if err := conn.QueryRowContext(ctx, "PRAGMA data_version").Scan(&version); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Errorf("keystore: get data version: %v", err)
+		} else if version != lastVersion {
+			// Keys are reloaded on the first check too, in case they were changed after NewKeyStore.
+			if err := s.Reload(ctx); err != nil {
+				log.Errorf("keystore: reload: %v", err)
+			} else {
+				lastVersion = version
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package raid
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyStore(t *testing.T) {
+	chdirTemp(t)
+
+	ctx := context.Background()
+	keys := NewKeyStore("keys", []string{"static"})
+
+	if apiKey := keys.Lookup("static"); apiKey == nil || apiKey.Owner != "settings" || apiKey.IsRestricted() {
+		t.Fatalf("static key = %+v, want unrestricted key of settings", apiKey)
+	}
+
+	if keys.Lookup("") != nil || keys.Lookup("unknown") != nil {
+		t.Fatal("unknown key was found")
+	}
+
+	changed := keys.Changed()
+
+	created, key, err := keys.Create(ctx, APIKey{Owner: "alice", Enabled: true, Regions: []int{12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("Changed was not closed after Create")
+	}
+
+	// Only hash of the key is stored, and keys are found by it.
+	apiKey := keys.Lookup(key)
+	if apiKey == nil || apiKey.ID != created.ID || apiKey.Hash != hashAPIKey(key) || apiKey.Hash == key {
+		t.Fatalf("Lookup(%q) = %+v, want key %d", key, apiKey, created.ID)
+	}
+
+	if !apiKey.Allows(12) || apiKey.Allows(14) {
+		t.Fatalf("key with regions %v allows wrong states", apiKey.Regions)
+	}
+
+	if found := keys.FindByHash(hashAPIKey(key)); found == nil || found.ID != created.ID {
+		t.Fatalf("FindByHash = %+v, want key %d", found, created.ID)
+	}
+
+	if found := keys.FindByHash(hashAPIKey("static")); found == nil || found.Owner != "settings" {
+		t.Fatalf("FindByHash of static key = %+v", found)
+	}
+
+	// Disabled and expired keys are not accepted, but are still found by hash for usage stats.
+	disabled := false
+	if _, err := keys.Update(ctx, created.ID, KeyUpdate{Enabled: &disabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys.Lookup(key) != nil || keys.IsCurrent(key, apiKey) {
+		t.Fatal("disabled key was accepted")
+	}
+
+	if keys.FindByHash(hashAPIKey(key)) == nil {
+		t.Fatal("disabled key was not found by hash")
+	}
+
+	enabled := true
+	expiresAt := time.Now().Add(-time.Minute)
+
+	if _, err := keys.Update(ctx, created.ID, KeyUpdate{Enabled: &enabled, SetExpiresAt: true, ExpiresAt: &expiresAt}); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys.Lookup(key) != nil {
+		t.Fatal("expired key was accepted")
+	}
+
+	if err := keys.Delete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys.FindByHash(hashAPIKey(key)) != nil {
+		t.Fatal("deleted key was found")
+	}
+
+	if err := keys.Delete(ctx, created.ID); err == nil {
+		t.Fatal("deleting missing key did not fail")
+	}
+}
+
+func TestKeyStoreReload(t *testing.T) {
+	chdirTemp(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	errch := make(chan error, 1)
+
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// Another instance on the same DB acts as another process, e.g. "raid keys".
+	keys := NewKeyStore("keys", nil)
+	other := NewKeyStore("keys", nil)
+
+	go keys.Run(ctx, wg, errch)
+
+	changed := keys.Changed()
+
+	_, key, err := other.Create(ctx, APIKey{Owner: "bob", Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for keys.Lookup(key) == nil {
+		select {
+		case <-changed:
+			changed = keys.Changed()
+		case err := <-errch:
+			t.Fatal(err)
+		case <-time.After(5 * keyStorePollInterval):
+			t.Fatal("key created by another process was not loaded")
+		}
+	}
+}
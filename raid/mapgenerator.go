@@ -12,6 +12,7 @@ import (
 	"text/template"
 
 	"github.com/golang/freetype"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"github.com/srwiley/oksvg"
 	"github.com/srwiley/rasterx"
@@ -28,19 +29,38 @@ const MapWidth = 1000
 const MapHeight = 670
 
 type MapData struct {
-	ContentType string
-	Bytes       []byte
+	contentType string
+	bytes       []byte
+	mutex       sync.RWMutex
+}
+
+func (d *MapData) Get() (string, []byte) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.contentType, d.bytes
+}
+
+func (d *MapData) Set(contentType string, data []byte) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.contentType = contentType
+	d.bytes = data
 }
 
 type MapGenerator struct {
+	registry     *Registry
 	updaterState *UpdaterState
 	updates      *Topic[Update]
 	mapTemplate  *template.Template
 	fontContext  *freetype.Context
-	MapData      *MapData
+	// Guards fontContext since maps of past states are rendered on demand.
+	fontMutex sync.Mutex
+	MapData   *MapData
 }
 
-func NewMapGenerator(updaterState *UpdaterState, updates *Topic[Update]) *MapGenerator {
+func NewMapGenerator(registry *Registry, updaterState *UpdaterState, updates *Topic[Update]) *MapGenerator {
 	mapTemplate, err := template.New("maptemplate").Parse(mapTemplateStr)
 	if err != nil {
 		log.Fatalf("mapgenerator: parse map template: %s", err)
@@ -58,8 +78,15 @@ func NewMapGenerator(updaterState *UpdaterState, updates *Topic[Update]) *MapGen
 	fontCtx.SetSrc(image.Black)
 	fontCtx.SetHinting(font.HintingFull)
 
-	g := &MapGenerator{updaterState, updates, mapTemplate, fontCtx, &MapData{}}
-	if err := g.GenerateMap(updaterState, "", true); err != nil {
+	g := &MapGenerator{
+		registry:     registry,
+		updaterState: updaterState,
+		updates:      updates,
+		mapTemplate:  mapTemplate,
+		fontContext:  fontCtx,
+		MapData:      &MapData{},
+	}
+	if err := g.GenerateMap(updaterState.Snapshot(), "", true); err != nil {
 		log.Fatalf("mapgenerator: generate initial map: %s", err)
 	}
 
@@ -72,7 +99,7 @@ func (g *MapGenerator) Run(ctx context.Context, wg *sync.WaitGroup, errch chan e
 	defer wg.Done()
 	wg.Add(1)
 
-	events := g.updates.Subscribe("mapgenerator", func(u Update) bool {
+	events := g.updates.Subscribe("mapgenerator", OverflowBlock, func(u Update) bool {
 		return u.IsFresh
 	})
 	defer g.updates.Unsubscribe(events)
@@ -88,7 +115,7 @@ func (g *MapGenerator) Run(ctx context.Context, wg *sync.WaitGroup, errch chan e
 				continue
 			}
 
-			if err := g.GenerateMap(g.updaterState, "", true); err != nil {
+			if err := g.GenerateMap(g.updaterState.Snapshot(), "", true); err != nil {
 				errch <- fmt.Errorf("mapgenerator: regenerate map: %w", err)
 
 				return
@@ -99,15 +126,36 @@ func (g *MapGenerator) Run(ctx context.Context, wg *sync.WaitGroup, errch chan e
 	}
 }
 
-func (g *MapGenerator) GenerateMap(updaterState *UpdaterState, title string, transparent bool) error {
-	stateAlerts := map[int]bool{}
-	for _, state := range updaterState.States {
-		stateAlerts[state.ID] = state.Alert
+func (g *MapGenerator) GenerateMap(snapshot *UpdaterSnapshot, title string, transparent bool) error {
+	data, err := g.RenderMap(snapshot, title, transparent)
+	if err != nil {
+		return err
+	}
+
+	g.MapData.Set("image/png", data)
+
+	log.Infof("mapgenerator: generate map complete, size = %d B", len(data))
+
+	return nil
+}
+
+// RenderMap renders PNG map of the snapshot without changing MapData.
+func (g *MapGenerator) RenderMap(snapshot *UpdaterSnapshot, title string, transparent bool) ([]byte, error) {
+	timer := prometheus.NewTimer(mapRenderDuration)
+	defer timer.ObserveDuration()
+
+	// Map template refers to regions by their SVG path IDs.
+	stateAlerts := map[string]bool{}
+
+	for _, state := range snapshot.States {
+		if region := g.registry.Find(state.ID); region != nil && region.SVGPath != "" {
+			stateAlerts[region.SVGPath] = state.Alert
+		}
 	}
 
 	mapStr := bytes.NewBuffer(nil)
 	if err := g.mapTemplate.Execute(mapStr, map[string]interface{}{"alerts": stateAlerts}); err != nil {
-		return fmt.Errorf("mapgenerator: execute map template: %w", err)
+		return nil, fmt.Errorf("mapgenerator: execute map template: %w", err)
 	}
 
 	svg, _ := oksvg.ReadIconStream(mapStr)
@@ -122,23 +170,29 @@ func (g *MapGenerator) GenerateMap(updaterState *UpdaterState, title string, tra
 	svg.Draw(rasterx.NewDasher(MapWidth, MapHeight, rasterx.NewScannerGV(MapWidth, MapHeight, rgba, rgba.Bounds())), 1)
 
 	if len(title) > 0 {
-		g.fontContext.SetClip(rgba.Bounds())
-		g.fontContext.SetDst(rgba)
-
-		if _, err := g.fontContext.DrawString(title, freetype.Pt(50, 500)); err != nil {
-			return fmt.Errorf("mapgenerator: draw text: %w", err)
+		if err := g.drawTitle(rgba, title); err != nil {
+			return nil, err
 		}
 	}
 
 	out := bytes.NewBuffer(nil)
 	if err := png.Encode(out, rgba); err != nil {
-		return fmt.Errorf("mapgenerator: encode png map: %w", err)
+		return nil, fmt.Errorf("mapgenerator: encode png map: %w", err)
 	}
 
-	g.MapData.ContentType = "image/png"
-	g.MapData.Bytes = out.Bytes()
+	return out.Bytes(), nil
+}
+
+func (g *MapGenerator) drawTitle(rgba *image.RGBA, title string) error {
+	g.fontMutex.Lock()
+	defer g.fontMutex.Unlock()
 
-	log.Infof("mapgenerator: generate map complete, size = %d B", len(g.MapData.Bytes))
+	g.fontContext.SetClip(rgba.Bounds())
+	g.fontContext.SetDst(rgba)
+
+	if _, err := g.fontContext.DrawString(title, freetype.Pt(50, 500)); err != nil {
+		return fmt.Errorf("mapgenerator: draw text: %w", err)
+	}
 
 	return nil
 }
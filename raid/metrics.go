@@ -0,0 +1,188 @@
+package raid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	scrapeDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "raid_scrape_duration_seconds",
+		Help: "Duration of Telegram channel page requests.",
+	})
+	scrapeErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "raid_scrape_errors_total",
+		Help: "Number of failed Telegram channel page requests.",
+	})
+	processedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "raid_messages_processed_total",
+		Help: "Number of processed messages by result: parsed if the message changed a region, unparsed otherwise.",
+	}, []string{"result"})
+	rateLimitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "raid_rate_limit_rejections_total",
+		Help: "Number of requests rejected by rate limiters.",
+	}, []string{"limiter"})
+	mapRenderDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "raid_map_render_duration_seconds",
+		Help: "Duration of map rendering.",
+	})
+	deloreanInsertDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "raid_delorean_insert_duration_seconds",
+		Help:    "Duration of adding a record to history.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+	})
+	webhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "raid_webhook_deliveries_total",
+		Help: "Number of webhook delivery attempts by result: delivered, retry or failed if retries were exhausted.",
+	}, []string{"result"})
+	announcedDatagrams = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "raid_announced_datagrams_total",
+		Help: "Number of UDP datagrams sent by announcer by result: sent or error.",
+	}, []string{"result"})
+	subscriberDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "raid_subscriber_dropped_total",
+		Help: "Number of updates dropped for subscribers by kind and overflow policy.",
+	}, []string{"kind", "policy"})
+)
+
+var (
+	subscribersDesc = prometheus.NewDesc(
+		"raid_subscribers", "Number of active update subscribers by kind.", []string{"kind"}, nil,
+	)
+	subscriberQueueDesc = prometheus.NewDesc(
+		"raid_subscriber_queue_depth", "Number of updates queued for subscribers by kind and overflow policy.",
+		[]string{"kind", "policy"}, nil,
+	)
+	regionAlertDesc = prometheus.NewDesc(
+		"raid_region_alert", "Whether an alert is active in a region (1) or not (0).",
+		[]string{"region_id", "region", "alert_type"}, nil,
+	)
+)
+
+// stateCollector exports metrics that are computed from the current state on every scrape.
+type stateCollector struct {
+	updaterState *UpdaterState
+	updates      *Topic[Update]
+}
+
+func (c stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- subscribersDesc
+	ch <- subscriberQueueDesc
+	ch <- regionAlertDesc
+}
+
+func (c stateCollector) Collect(ch chan<- prometheus.Metric) {
+	kinds := map[string]int{"api": 0, "ws": 0, "tcpserver": 0}
+	queued := map[[2]string]int{}
+
+	for _, stats := range c.updates.Stats() {
+		kind := subscriberKind(stats.Name)
+		kinds[kind]++
+		queued[[2]string{kind, stats.Policy.String()}] += stats.Queued
+	}
+
+	for kind, count := range kinds {
+		ch <- prometheus.MustNewConstMetric(subscribersDesc, prometheus.GaugeValue, float64(count), kind)
+	}
+
+	for labels, count := range queued {
+		ch <- prometheus.MustNewConstMetric(subscriberQueueDesc, prometheus.GaugeValue, float64(count), labels[0], labels[1])
+	}
+
+	regionAlert := func(id int, name string, alerts []AlertType) {
+		for _, alertType := range AlertTypes {
+			value := 0.0
+			if hasAlert(alerts, alertType) {
+				value = 1
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				regionAlertDesc, prometheus.GaugeValue, value, strconv.Itoa(id), name, string(alertType),
+			)
+		}
+	}
+
+	for _, state := range c.updaterState.Snapshot().States {
+		regionAlert(state.ID, state.NameEn, state.Alerts)
+
+		for _, district := range state.Districts {
+			regionAlert(district.ID, district.NameEn, district.Alerts)
+		}
+	}
+}
+
+// subscriberKind returns kind of subscriber, since names are "<kind>-<remote address>" for clients,
+// which must not be used as labels, or every connection would create new time series.
+func subscriberKind(name string) string {
+	return strings.SplitN(name, "-", 2)[0]
+}
+
+type MetricsServer struct {
+	port uint16
+}
+
+func NewMetricsServer(port uint16, updaterState *UpdaterState, updates *Topic[Update]) *MetricsServer {
+	if err := prometheus.Register(stateCollector{updaterState, updates}); err != nil {
+		log.Fatalf("metrics: register state collector: %s", err)
+	}
+
+	// Export all known label values right away, so that rates can be calculated from the first increment.
+	for _, result := range []string{"parsed", "unparsed"} {
+		processedMessages.WithLabelValues(result)
+	}
+
+	for _, limiter := range []string{"address", "api_key"} {
+		rateLimitRejections.WithLabelValues(limiter)
+	}
+
+	for _, result := range []string{"delivered", "retry", "failed"} {
+		webhookDeliveries.WithLabelValues(result)
+	}
+
+	for _, result := range []string{"sent", "error"} {
+		announcedDatagrams.WithLabelValues(result)
+	}
+
+	return &MetricsServer{port}
+}
+
+func (m *MetricsServer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("metrics: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", m.port),
+		Handler: metricsMux,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				errch <- fmt.Errorf("metrics: server stopped: %w", err)
+
+				return
+			}
+		}
+	}()
+
+	<-ctx.Done()
+
+	if err := server.Shutdown(context.Background()); err != nil {
+		log.Errorf("metrics: shutdown: %s", err)
+	}
+}
@@ -0,0 +1,160 @@
+package raid
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Migrations of every database are stored in its own directory, named "<version>_<description>.sql"
+// and applied in the order of their versions.
+//
+//go:embed assets/migrations
+var migrationsFS embed.FS
+
+// Statements of a migration are separated by semicolons at line ends.
+var statementSeparator = regexp.MustCompile(`;[ \t]*(\n|$)`)
+
+// Columns added by migrations may already exist in databases of builds which added them at startup
+// before migrations were introduced, so such statements are skipped if the column exists.
+var addColumnStatement = regexp.MustCompile(`(?im)^ALTER\s+TABLE\s+(\w+)\s+ADD\s+COLUMN\s+(\w+)`)
+
+type migration struct {
+	version int
+	name    string
+	query   string
+}
+
+func loadMigrations(dir string) ([]migration, error) {
+	entries, err := migrationsFS.ReadDir(path.Join("assets/migrations", dir))
+	if err != nil {
+		return nil, fmt.Errorf("migrations: list: %w", err)
+	}
+
+	migrations := []migration{}
+
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".sql")
+
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("migrations: invalid name %s: %w", entry.Name(), err)
+		}
+
+		query, err := migrationsFS.ReadFile(path.Join("assets/migrations", dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("migrations: read %s: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, migration{version, name, string(query)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	for i, m := range migrations {
+		if m.version != i+1 {
+			return nil, fmt.Errorf("migrations: expected version %d, got %s", i+1, m.name)
+		}
+	}
+
+	return migrations, nil
+}
+
+// migrate applies pending migrations from dir, each in its own transaction, and records their versions.
+// It fails if the database was migrated by a newer version of the app.
+func migrate(ctx context.Context, db *sql.DB, dir string) error {
+	migrations, err := loadMigrations(dir)
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version integer NOT NULL PRIMARY KEY,
+			applied_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`); err != nil {
+		return fmt.Errorf("migrations: create schema_migrations: %w", err)
+	}
+
+	var version int
+	if err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return fmt.Errorf("migrations: get schema version: %w", err)
+	}
+
+	if version > len(migrations) {
+		return fmt.Errorf("migrations: schema version %d is newer than %d supported by this app", version, len(migrations))
+	}
+
+	for _, m := range migrations[version:] {
+		log.Infof("migrations: apply %s", m.name)
+
+		if err := applyMigration(ctx, db, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyMigration(ctx context.Context, db *sql.DB, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migrations: begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, statement := range statementSeparator.Split(m.query, -1) {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+
+		if match := addColumnStatement.FindStringSubmatch(statement); match != nil {
+			exists, err := columnExists(ctx, tx, match[1], match[2])
+			if err != nil {
+				return fmt.Errorf("migrations: apply %s: %w", m.name, err)
+			}
+
+			if exists {
+				log.Infof("migrations: skip existing column %s.%s", match[1], match[2])
+
+				continue
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migrations: apply %s: %w", m.name, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES (?)", m.version); err != nil {
+		return fmt.Errorf("migrations: record version of %s: %w", m.name, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("migrations: commit %s: %w", m.name, err)
+	}
+
+	return nil
+}
+
+func columnExists(ctx context.Context, tx *sql.Tx, table string, column string) (bool, error) {
+	var count int
+	if err := tx.QueryRowContext(
+		ctx, "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?", table, column,
+	).Scan(&count); err != nil {
+		return false, fmt.Errorf("get columns of %s: %w", table, err)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,62 @@
+package raid
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestMigrateLegacyHistory(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"empty", ""},
+		{"before alert types", "CREATE TABLE events (id integer PRIMARY KEY, date timestamp, state_id integer, alert bool)"},
+		{
+			// Columns, indexes and tables were created at startup before migrations were introduced.
+			"before migrations", `
+				CREATE TABLE events (id integer PRIMARY KEY, date timestamp, state_id integer, alert bool);
+				ALTER TABLE events ADD COLUMN alert_type text NOT NULL DEFAULT 'air_raid';
+				ALTER TABLE events ADD COLUMN district_id integer NOT NULL DEFAULT 0;
+				ALTER TABLE events ADD COLUMN event_id integer NOT NULL DEFAULT 0;
+				CREATE INDEX events_state_id ON events (state_id, id);
+				CREATE TABLE checkpoints (record_id integer NOT NULL PRIMARY KEY, date timestamp, snapshot text);
+				INSERT INTO events (date, state_id, alert, district_id) VALUES ('2022-10-18 12:00:00+00:00', 12, 1, 0);
+			`,
+		},
+	}
+
+	for _, test := range tests {
+		db, err := sql.Open("sqlite3", "file::memory:?cache=private")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		db.SetMaxOpenConns(1)
+
+		if _, err := db.ExecContext(ctx, test.schema); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if err := migrate(ctx, db, "history"); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+
+		var count int
+		if err := db.QueryRowContext(
+			ctx, "SELECT COUNT(*) FROM pragma_table_info('events') WHERE name IN ('alert_type', 'district_id', 'message_id')",
+		).Scan(&count); err != nil || count != 3 {
+			t.Errorf("%s: got %d new columns of events (%v), want 3", test.name, count, err)
+		}
+
+		// Migrations are applied only once.
+		if err := migrate(ctx, db, "history"); err != nil {
+			t.Errorf("%s: migrate again: %v", test.name, err)
+		}
+
+		db.Close()
+	}
+}
@@ -0,0 +1,76 @@
+package raid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type AlertType string
+
+const (
+	AlertAirRaid     AlertType = "air_raid"
+	AlertArtillery   AlertType = "artillery"
+	AlertUrbanFights AlertType = "urban_fights"
+	AlertChemical    AlertType = "chemical"
+	AlertNuclear     AlertType = "nuclear"
+)
+
+var AlertTypes = []AlertType{AlertAirRaid, AlertArtillery, AlertUrbanFights, AlertChemical, AlertNuclear}
+
+var ErrUnknownAlert = errors.New("unknown alert type")
+
+type alertRule struct {
+	alertType AlertType
+	keywords  []string
+}
+
+// Rules are matched in order, so more specific ones must come first:
+// e.g. "Відбій загрози артобстрілу" mentions neither "тривог" nor an air raid.
+var alertRules = []alertRule{
+	{AlertArtillery, []string{"артобстріл", "артилерійськ"}},
+	{AlertUrbanFights, []string{"вуличних боїв"}},
+	{AlertChemical, []string{"хімічн"}},
+	{AlertNuclear, []string{"радіаційн", "ядерн"}},
+	{AlertAirRaid, []string{"повітряна тривога", "відбій тривоги"}},
+}
+
+var alertOffKeywords = []string{"відбій"}
+
+// ParseAlert classifies a message sentence into an alert type and an on/off action.
+func ParseAlert(sentence string) (AlertType, bool, error) {
+	lower := strings.ToLower(sentence)
+
+	on := true
+
+	for _, keyword := range alertOffKeywords {
+		if strings.Contains(lower, keyword) {
+			on = false
+		}
+	}
+
+	for _, rule := range alertRules {
+		for _, keyword := range rule.keywords {
+			if strings.Contains(lower, keyword) {
+				return rule.alertType, on, nil
+			}
+		}
+	}
+
+	// Any other all-clear is about an air raid, e.g. "Відбій повітряної тривоги".
+	if !on {
+		return AlertAirRaid, false, nil
+	}
+
+	return "", false, fmt.Errorf("parser: %w in \"%s\"", ErrUnknownAlert, sentence)
+}
+
+func ParseAlertType(name string) (AlertType, error) {
+	for _, alertType := range AlertTypes {
+		if string(alertType) == name {
+			return alertType, nil
+		}
+	}
+
+	return "", fmt.Errorf("parser: %w: %s", ErrUnknownAlert, name)
+}
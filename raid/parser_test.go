@@ -0,0 +1,47 @@
+package raid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAlert(t *testing.T) {
+	tests := []struct {
+		sentence  string
+		alertType AlertType
+		on        bool
+	}{
+		{"Повітряна тривога в Львівська область", AlertAirRaid, true},
+		{"Відбій тривоги в Львівська область", AlertAirRaid, false},
+		{"Відбій повітряної тривоги в Львівська область", AlertAirRaid, false},
+		{"Відбій в Львівська область", AlertAirRaid, false},
+		{"Загроза артобстрілу в Херсонська область", AlertArtillery, true},
+		{"Відбій загрози артобстрілу в Херсонська область", AlertArtillery, false},
+		{"Загроза вуличних боїв в Херсонська область", AlertUrbanFights, true},
+		{"Відбій загрози вуличних боїв в Херсонська область", AlertUrbanFights, false},
+		{"Хімічна загроза в Запорізька область", AlertChemical, true},
+		{"Відбій хімічної загрози в Запорізька область", AlertChemical, false},
+		{"Радіаційна загроза в Запорізька область", AlertNuclear, true},
+		{"Загроза застосування ядерної зброї в Запорізька область", AlertNuclear, true},
+		{"Відбій радіаційної загрози в Запорізька область", AlertNuclear, false},
+	}
+
+	for _, test := range tests {
+		alertType, on, err := ParseAlert(test.sentence)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.sentence, err)
+
+			continue
+		}
+
+		if alertType != test.alertType || on != test.on {
+			t.Errorf("%q: got %s %v, want %s %v", test.sentence, alertType, on, test.alertType, test.on)
+		}
+	}
+}
+
+func TestParseAlertUnknown(t *testing.T) {
+	if _, _, err := ParseAlert("Тестове повідомлення"); !errors.Is(err, ErrUnknownAlert) {
+		t.Errorf("got %v, want %v", err, ErrUnknownAlert)
+	}
+}
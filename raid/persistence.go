@@ -2,11 +2,20 @@ package raid
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// ErrCorruptData is returned by Load when the file cannot be decoded.
+// The file is moved aside, so the next Load starts with empty data.
+var ErrCorruptData = errors.New("persistence: corrupt data")
+
 type Persistence[T interface{}] struct {
 	path string
 	Data T
@@ -38,43 +47,79 @@ func (p *Persistence[T]) open(flag int) (*os.File, error) {
 }
 
 func (p *Persistence[T]) Load() error {
-	var (
-		f   *os.File
-		err error
-	)
-
-	if f, err = p.open(os.O_RDONLY | os.O_CREATE); err != nil {
+	f, err := p.open(os.O_RDONLY | os.O_CREATE)
+	if err != nil {
 		return fmt.Errorf("persistence: open database for read: %w", err)
 	}
+	defer f.Close()
 
 	dec := json.NewDecoder(f)
-	if err = dec.Decode(&p.Data); err != nil {
-		if err.Error() != "EOF" {
-			return fmt.Errorf("persistence: decode database: %w", err)
+	if err = dec.Decode(&p.Data); err != nil && !errors.Is(err, io.EOF) {
+		corruptPath := fmt.Sprintf("%s.corrupt-%d", p.path, time.Now().Unix())
+		if renameErr := os.Rename(p.path, corruptPath); renameErr != nil {
+			return fmt.Errorf("persistence: move corrupt database aside: %w", renameErr)
 		}
+
+		log.Errorf("persistence: move corrupt database to %s", corruptPath)
+
+		return fmt.Errorf("%w: %v", ErrCorruptData, err)
 	}
 
 	return nil
 }
 
+// Save writes data to a temporary file which replaces the old one only when it's completely written,
+// so a crash never leaves a partially written file behind.
 func (p *Persistence[T]) Save() error {
-	var (
-		f    *os.File
-		err  error
-		data []byte
-	)
+	data, err := json.MarshalIndent(&p.Data, "", "    ")
+	if err != nil {
+		return fmt.Errorf("persistence: encode database: %w", err)
+	}
 
-	if f, err = p.open(os.O_WRONLY | os.O_CREATE | os.O_TRUNC); err != nil {
-		return fmt.Errorf("persistence: open database for write: %w", err)
+	if err := os.MkdirAll(path.Dir(p.path), 0o755); err != nil {
+		return fmt.Errorf("persistence: create directories: %w", err)
 	}
 
-	if data, err = json.MarshalIndent(&p.Data, "", "    "); err != nil {
-		return fmt.Errorf("persistence: encode database: %w", err)
+	f, err := os.CreateTemp(path.Dir(p.path), path.Base(p.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("persistence: create temporary file: %w", err)
 	}
+	defer os.Remove(f.Name())
 
 	if _, err := f.Write(data); err != nil {
+		f.Close()
+
 		return fmt.Errorf("persistence: write database: %w", err)
 	}
 
+	if err := f.Sync(); err != nil {
+		f.Close()
+
+		return fmt.Errorf("persistence: sync database: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("persistence: close database: %w", err)
+	}
+
+	if err := os.Chmod(f.Name(), 0o644); err != nil {
+		return fmt.Errorf("persistence: change database mode: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), p.path); err != nil {
+		return fmt.Errorf("persistence: replace database: %w", err)
+	}
+
+	// Make sure the rename itself is durable.
+	dir, err := os.Open(path.Dir(p.path))
+	if err != nil {
+		return fmt.Errorf("persistence: open directory: %w", err)
+	}
+	defer dir.Close()
+
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("persistence: sync directory: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,91 @@
+package raid
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type persistenceTestData struct {
+	Value   int         `json:"value"`
+	Invalid interface{} `json:"invalid,omitempty"`
+}
+
+func TestPersistenceSave(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data", "state.json")
+
+	p, err := NewPersistence(&persistenceTestData{}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.Data.Value = 42
+	if err := p.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Failed save must keep the previous file intact.
+	p.Data.Value = 43
+	p.Data.Invalid = make(chan struct{})
+
+	if err := p.Save(); err == nil {
+		t.Error("got no error when saving data that cannot be encoded")
+	}
+
+	loaded, err := NewPersistence(&persistenceTestData{}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Data.Value != 42 {
+		t.Errorf("got value %d, want 42", loaded.Data.Value)
+	}
+
+	// Temporary files are removed after both successful and failed saves.
+	files, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 || files[0].Name() != "state.json" {
+		t.Errorf("got files %v, want only state.json", files)
+	}
+}
+
+func TestPersistenceCorruptData(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	if err := os.WriteFile(path, []byte(`{"value": 4`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewPersistence(&persistenceTestData{}, path); !errors.Is(err, ErrCorruptData) {
+		t.Fatalf("got error %v, want %v", err, ErrCorruptData)
+	}
+
+	corrupt, err := filepath.Glob(path + ".corrupt-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(corrupt) != 1 {
+		t.Fatalf("got corrupt files %v, want one", corrupt)
+	}
+
+	if data, _ := os.ReadFile(corrupt[0]); string(data) != `{"value": 4` {
+		t.Errorf("got corrupt file content %q", data)
+	}
+
+	// Corrupt file was moved aside, so the next load starts with empty data.
+	p, err := NewPersistence(&persistenceTestData{}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Data.Value != 0 {
+		t.Errorf("got value %d, want 0", p.Data.Value)
+	}
+}
@@ -2,6 +2,7 @@ package raid
 
 import (
 	"sync"
+	"sync/atomic"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -12,61 +13,251 @@ func FilterAll[T interface{}](T) bool {
 	return true
 }
 
+// OverflowPolicy defines what Broadcast does when a subscriber's channel is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until the subscriber reads from the channel. No payloads are lost,
+	// but a slow subscriber delays everyone, so it's meant only for internal subscribers.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest queued payload to make room for the new one.
+	OverflowDropOldest
+	// OverflowDropNewest discards the new payload.
+	OverflowDropNewest
+	// OverflowDisconnect discards the new payload and closes the channel.
+	OverflowDisconnect
+)
+
+func (p OverflowPolicy) String() string {
+	return [...]string{"block", "drop-oldest", "drop-newest", "disconnect"}[p]
+}
+
+const subscriberBufferSize = 32
+
+type subscriber[T interface{}] struct {
+	name    string
+	filter  FilterFunc[T]
+	policy  OverflowPolicy
+	ch      chan T
+	dropped uint64
+	// done is closed on unsubscribe to interrupt a blocked send.
+	done chan struct{}
+	// mutex guards sending to ch against closing it.
+	mutex  sync.Mutex
+	closed bool
+}
+
+// SubscriberStats describes a subscriber for monitoring.
+type SubscriberStats struct {
+	Name    string
+	Policy  OverflowPolicy
+	Queued  int
+	Dropped uint64
+}
+
 type Topic[T interface{}] struct {
-	channels        map[chan T]FilterFunc[T]
-	subscriberNames map[chan T]string
-	mutex           sync.Mutex
+	subscribers map[chan T]*subscriber[T]
+	history     []T
+	historySize int
+	// mutex guards subscribers and history, broadcastMutex keeps payloads in order.
+	mutex          sync.Mutex
+	broadcastMutex sync.Mutex
 }
 
 func NewTopic[T interface{}]() *Topic[T] {
+	return NewTopicWithHistory[T](0)
+}
+
+// NewTopicWithHistory creates a topic that remembers historySize last payloads
+// so that subscribers can catch up with what they have missed.
+func NewTopicWithHistory[T interface{}](historySize int) *Topic[T] {
 	return &Topic[T]{
-		channels:        make(map[chan T]FilterFunc[T]),
-		subscriberNames: make(map[chan T]string),
+		subscribers: make(map[chan T]*subscriber[T]),
+		history:     []T{},
+		historySize: historySize,
 	}
 }
 
-func (t *Topic[T]) sendSafe(ch chan T, payload T) (ok bool) {
-	// https://groups.google.com/g/golang-nuts/c/6bL3lXoC4Ek
-	// TODO: When channel write is blocked, it's better to make Broadcast unlock mutex
-	// to give subscribers time to unsubscribe.
-	defer func() { recover() }()
-	ch <- payload
+// Broadcast sends payload to all subscribers whose filters accept it.
+// It only waits for subscribers with OverflowBlock policy.
+func (t *Topic[T]) Broadcast(payload T) {
+	t.broadcastMutex.Lock()
+	defer t.broadcastMutex.Unlock()
+
+	t.mutex.Lock()
+
+	if t.historySize > 0 {
+		t.history = append(t.history, payload)
+		if len(t.history) > t.historySize {
+			t.history = t.history[len(t.history)-t.historySize:]
+		}
+	}
+
+	subscribers := make([]*subscriber[T], 0, len(t.subscribers))
+	for _, sub := range t.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	t.mutex.Unlock()
+
+	for _, sub := range subscribers {
+		if sub.filter(payload) && !sub.send(payload) {
+			t.remove(sub)
+		}
+	}
+}
+
+// send delivers payload according to the subscriber's policy.
+// It returns false if the subscriber was disconnected.
+func (s *subscriber[T]) send(payload T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return true
+	}
+
+	select {
+	case s.ch <- payload:
+		return true
+	default:
+	}
+
+	switch s.policy {
+	case OverflowBlock:
+		log.Warnf("pubsub: broadcast: channel %s is full, will block", s.name)
+
+		select {
+		case s.ch <- payload:
+		case <-s.done:
+		}
+	case OverflowDropOldest:
+		// Subscriber may have drained the channel meanwhile, then nothing is evicted.
+		select {
+		case <-s.ch:
+			s.drop()
+		default:
+		}
+
+		select {
+		case s.ch <- payload:
+		default:
+			s.drop()
+		}
+	case OverflowDropNewest:
+		s.drop()
+	case OverflowDisconnect:
+		s.drop()
+		// Subscriber is removed here, so its drops are not logged on unsubscribe.
+		log.Warnf(
+			"pubsub: broadcast: channel %s is full, disconnect with %d payloads dropped in total",
+			s.name, atomic.LoadUint64(&s.dropped),
+		)
+
+		s.closed = true
+		close(s.ch)
+
+		return false
+	}
 
 	return true
 }
 
-func (t *Topic[T]) Broadcast(payload T) {
+func (s *subscriber[T]) drop() {
+	atomic.AddUint64(&s.dropped, 1)
+	subscriberDropped.WithLabelValues(subscriberKind(s.name), s.policy.String()).Inc()
+}
+
+func (t *Topic[T]) Subscribe(name string, policy OverflowPolicy, filter func(T) bool) chan T {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.add(name, policy, filter)
+}
+
+// SubscribeWithHistory atomically subscribes to the topic and returns remembered payloads
+// that pass both since and filter, so that no payload is missed or received twice.
+func (t *Topic[T]) SubscribeWithHistory(
+	name string, policy OverflowPolicy, filter func(T) bool, since func(T) bool,
+) (chan T, []T) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	for ch, filter := range t.channels {
-		if filter(payload) {
-			if len(ch) == cap(ch) {
-				log.Warnf("pubsub: broadcast: channel %s is full, will block", t.subscriberNames[ch])
-			}
-			t.sendSafe(ch, payload)
+	missed := []T{}
+
+	for _, payload := range t.history {
+		if since(payload) && filter(payload) {
+			missed = append(missed, payload)
 		}
 	}
+
+	return t.add(name, policy, filter), missed
 }
 
-func (t *Topic[T]) Subscribe(name string, filter func(T) bool) chan T {
+func (t *Topic[T]) add(name string, policy OverflowPolicy, filter func(T) bool) chan T {
+	sub := &subscriber[T]{
+		name:   name,
+		filter: filter,
+		policy: policy,
+		ch:     make(chan T, subscriberBufferSize),
+		done:   make(chan struct{}),
+	}
+	t.subscribers[sub.ch] = sub
+
+	return sub.ch
+}
+
+func (t *Topic[T]) remove(sub *subscriber[T]) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	ch := make(chan T, 32)
-	t.channels[ch] = filter
-	t.subscriberNames[ch] = name
-
-	return ch
+	delete(t.subscribers, sub.ch)
 }
 
-func (t *Topic[T]) Unsubscribe(ch chan T) {
-	// https://groups.google.com/g/golang-nuts/c/6bL3lXoC4Ek
-	close(ch)
+// History returns remembered payloads, oldest first.
+func (t *Topic[T]) History() []T {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return append([]T{}, t.history...)
+}
 
+// Stats returns stats of current subscribers.
+func (t *Topic[T]) Stats() []SubscriberStats {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	delete(t.channels, ch)
-	delete(t.subscriberNames, ch)
+	result := make([]SubscriberStats, 0, len(t.subscribers))
+
+	for _, sub := range t.subscribers {
+		result = append(result, SubscriberStats{sub.name, sub.policy, len(sub.ch), atomic.LoadUint64(&sub.dropped)})
+	}
+
+	return result
+}
+
+// Unsubscribe removes the subscription and closes its channel, unless it was already closed on overflow.
+func (t *Topic[T]) Unsubscribe(ch chan T) {
+	t.mutex.Lock()
+	sub, ok := t.subscribers[ch]
+	delete(t.subscribers, ch)
+	t.mutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	close(sub.done)
+
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+
+	if dropped := atomic.LoadUint64(&sub.dropped); dropped > 0 {
+		log.Warnf("pubsub: %s dropped %d payloads", sub.name, dropped)
+	}
 }
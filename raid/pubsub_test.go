@@ -0,0 +1,81 @@
+package raid
+
+import "testing"
+
+func subscriberStats(t *testing.T, topic *Topic[int], name string) SubscriberStats {
+	t.Helper()
+
+	for _, stats := range topic.Stats() {
+		if stats.Name == name {
+			return stats
+		}
+	}
+
+	t.Fatalf("subscriber %s not found", name)
+
+	return SubscriberStats{}
+}
+
+func TestTopicDropOldest(t *testing.T) {
+	topic := NewTopic[int]()
+	ch := topic.Subscribe("test", OverflowDropOldest, FilterAll[int])
+
+	for i := 0; i < subscriberBufferSize+3; i++ {
+		topic.Broadcast(i)
+	}
+
+	if dropped := subscriberStats(t, topic, "test").Dropped; dropped != 3 {
+		t.Errorf("got %d dropped, want 3", dropped)
+	}
+
+	// The newest payloads are kept.
+	if payload := <-ch; payload != 3 {
+		t.Errorf("got oldest payload %d, want 3", payload)
+	}
+
+	topic.Unsubscribe(ch)
+}
+
+func TestTopicDropNewest(t *testing.T) {
+	topic := NewTopic[int]()
+	ch := topic.Subscribe("test", OverflowDropNewest, FilterAll[int])
+
+	for i := 0; i < subscriberBufferSize+2; i++ {
+		topic.Broadcast(i)
+	}
+
+	if dropped := subscriberStats(t, topic, "test").Dropped; dropped != 2 {
+		t.Errorf("got %d dropped, want 2", dropped)
+	}
+
+	if payload := <-ch; payload != 0 {
+		t.Errorf("got oldest payload %d, want 0", payload)
+	}
+
+	topic.Unsubscribe(ch)
+}
+
+func TestTopicDisconnect(t *testing.T) {
+	topic := NewTopic[int]()
+	ch := topic.Subscribe("test", OverflowDisconnect, FilterAll[int])
+
+	for i := 0; i < subscriberBufferSize+2; i++ {
+		topic.Broadcast(i)
+	}
+
+	if len(topic.Stats()) != 0 {
+		t.Error("subscriber was not removed")
+	}
+
+	// Queued payloads are still delivered before the channel is closed.
+	count := 0
+	for range ch {
+		count++
+	}
+
+	if count != subscriberBufferSize {
+		t.Errorf("got %d payloads, want %d", count, subscriberBufferSize)
+	}
+
+	topic.Unsubscribe(ch)
+}
@@ -0,0 +1,188 @@
+package raid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const pushSourceCapacity = 1000
+
+// PushSource is a Source that receives messages over HTTP instead of scraping them.
+// Messages are accepted as JSON (a single message or an array of messages) via
+// "POST /messages" with X-API-Key header of an active key without region restrictions.
+type PushSource struct {
+	port     uint16
+	keys     *KeyStore
+	messages []Message
+	// IDs are assigned after this one, so that they keep increasing across restarts.
+	lastID int64
+	mutex  sync.Mutex
+}
+
+func NewPushSource(port uint16, keys *KeyStore) *PushSource {
+	return &PushSource{
+		port:     port,
+		keys:     keys,
+		messages: []Message{},
+	}
+}
+
+// SkipIDs makes messages without ID get IDs after lastID, e.g. the last processed message ID before restart.
+func (p *PushSource) SkipIDs(lastID int64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.lastID < lastID {
+		p.lastID = lastID
+	}
+}
+
+func (p *PushSource) Push(messages ...Message) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, message := range messages {
+		lastID := p.lastID
+
+		if message.ID == 0 {
+			message.ID = lastID + 1
+		}
+
+		if message.ID <= lastID {
+			log.Warnf("pushsource: drop message with non-increasing ID %d (last ID = %d)", message.ID, lastID)
+
+			continue
+		}
+
+		if message.Date.IsZero() {
+			message.Date = time.Now().UTC()
+		}
+
+		p.messages = append(p.messages, message)
+		p.lastID = message.ID
+	}
+
+	if len(p.messages) > pushSourceCapacity {
+		p.messages = p.messages[len(p.messages)-pushSourceCapacity:]
+	}
+}
+
+func (p *PushSource) FetchLast(ctx context.Context, count int) ([]Message, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	start := len(p.messages) - count
+	if start < 0 {
+		start = 0
+	}
+
+	return append([]Message{}, p.messages[start:]...), nil
+}
+
+func (p *PushSource) FetchNewer(ctx context.Context, after int64) ([]Message, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result := []Message{}
+
+	for _, message := range p.messages {
+		if message.ID > after {
+			result = append(result, message)
+		}
+	}
+
+	return result, nil
+}
+
+func (p *PushSource) handleMessages(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	enc := json.NewEncoder(rw)
+
+	apiKey := p.keys.Lookup(r.Header.Get("x-api-key"))
+	if apiKey == nil {
+		rw.WriteHeader(403)
+		_ = enc.Encode(map[string]string{"error": "Unknown or missing X-API-Key value"})
+
+		return
+	}
+
+	// Messages may change any region.
+	if apiKey.IsRestricted() {
+		rw.WriteHeader(403)
+		_ = enc.Encode(map[string]string{"error": regionForbiddenMessage})
+
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(405)
+		_ = enc.Encode(map[string]string{"error": "Method not allowed"})
+
+		return
+	}
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		rw.WriteHeader(400)
+		_ = enc.Encode(map[string]string{"error": "Malformed JSON"})
+
+		return
+	}
+
+	messages := []Message{}
+	if err := json.Unmarshal(raw, &messages); err != nil {
+		message := Message{}
+		if err := json.Unmarshal(raw, &message); err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": "Expected a message or an array of messages"})
+
+			return
+		}
+
+		messages = append(messages, message)
+	}
+
+	log.Debugf("pushsource: receive %d messages", len(messages))
+	p.Push(messages...)
+
+	rw.WriteHeader(202)
+	_ = enc.Encode(map[string]int{"accepted": len(messages)})
+}
+
+func (p *PushSource) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("pushsource: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc("/messages", p.handleMessages)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", p.port),
+		Handler: serveMux,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				errch <- fmt.Errorf("pushsource: server stopped: %w", err)
+
+				return
+			}
+		}
+	}()
+
+	<-ctx.Done()
+
+	if err := server.Shutdown(context.Background()); err != nil {
+		log.Errorf("pushsource: shutdown: %s", err)
+	}
+}
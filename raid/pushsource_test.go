@@ -0,0 +1,60 @@
+package raid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPushSourceAuth(t *testing.T) {
+	chdirTemp(t)
+
+	ctx := context.Background()
+	keys := NewKeyStore("keys", []string{"static"})
+	source := NewPushSource(0, keys)
+
+	_, restricted, err := keys.Create(ctx, APIKey{Owner: "alice", Enabled: true, Regions: []int{12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, unrestricted, err := keys.Create(ctx, APIKey{Owner: "bob", Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	push := func(key string) int {
+		r := httptest.NewRequest(http.MethodPost, "/messages", strings.NewReader(`{"text": ["🔴 12:00"]}`))
+		r.Header.Set("X-API-Key", key)
+		rw := httptest.NewRecorder()
+		source.handleMessages(rw, r)
+
+		return rw.Code
+	}
+
+	for _, tc := range []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"static", "static", 202},
+		{"issued", unrestricted, 202},
+		{"restricted", restricted, 403},
+		{"unknown", "unknown", 403},
+	} {
+		if got := push(tc.key); got != tc.want {
+			t.Errorf("%s key: status = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	// Revoked keys are rejected immediately.
+	if err := keys.Delete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := push(unrestricted); got != 403 {
+		t.Errorf("revoked key: status = %d, want 403", got)
+	}
+}
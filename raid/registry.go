@@ -0,0 +1,252 @@
+package raid
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+
+	yaml "github.com/goccy/go-yaml"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:embed assets/regions.yml
+var defaultRegistryData []byte
+
+// Region is a registry entry for a state (top-level region) or a district (region with a parent).
+type Region struct {
+	ID      int               `yaml:"id"`
+	Parent  int               `yaml:"parent"`
+	Names   map[string]string `yaml:"names"`
+	Aliases []string          `yaml:"aliases"`
+	SVGPath string            `yaml:"svg_path"`
+}
+
+type Registry struct {
+	Version int      `yaml:"version"`
+	Regions []Region `yaml:"regions"`
+}
+
+// LoadRegistry loads region registry from path, or the embedded one if path is empty.
+func LoadRegistry(path string) (*Registry, error) {
+	data := defaultRegistryData
+
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("registry: read %s: %w", path, err)
+		}
+	}
+
+	registry := &Registry{}
+	if err := yaml.Unmarshal(data, registry); err != nil {
+		return nil, fmt.Errorf("registry: decode: %w", err)
+	}
+
+	if err := registry.validate(); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func MustLoadRegistry(path string) *Registry {
+	registry, err := LoadRegistry(path)
+	if err != nil {
+		log.Fatalf("registry: load: %s", err)
+	}
+
+	log.Infof("registry: load v%d with %d regions", registry.Version, len(registry.Regions))
+
+	return registry
+}
+
+func (r *Registry) validate() error {
+	if r.Version <= 0 {
+		return fmt.Errorf("registry: invalid version %d", r.Version)
+	}
+
+	seen := map[int]bool{}
+
+	for _, region := range r.Regions {
+		if region.ID <= 0 || seen[region.ID] {
+			return fmt.Errorf("registry: invalid or duplicate region ID %d", region.ID)
+		}
+
+		seen[region.ID] = true
+
+		if region.Names["uk"] == "" {
+			return fmt.Errorf("registry: region %d has no \"uk\" name", region.ID)
+		}
+	}
+
+	for _, region := range r.Regions {
+		if region.Parent == 0 {
+			continue
+		}
+
+		parent := r.Find(region.Parent)
+		if parent == nil {
+			return fmt.Errorf("registry: region %d has unknown parent %d", region.ID, region.Parent)
+		}
+
+		if parent.Parent != 0 {
+			return fmt.Errorf("registry: region %d is nested too deep, only states and districts are supported", region.ID)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) Find(id int) *Region {
+	for i, region := range r.Regions {
+		if region.ID == id {
+			return &r.Regions[i]
+		}
+	}
+
+	return nil
+}
+
+// Children returns regions whose parent is the given region ID. Parent ID 0 returns all states.
+func (r *Registry) Children(parent int) []Region {
+	result := []Region{}
+
+	for _, region := range r.Regions {
+		if region.Parent == parent {
+			result = append(result, region)
+		}
+	}
+
+	return result
+}
+
+func (r *Registry) MatchState(text string) *Region {
+	return r.match(text, func(region Region) bool { return region.Parent == 0 })
+}
+
+func (r *Registry) MatchDistrict(text string) *Region {
+	return r.match(text, func(region Region) bool { return region.Parent != 0 })
+}
+
+// match returns the region with the longest name found in text, since names may contain each other,
+// e.g. "Подільський район" is a part of "Кам’янець-Подільський район".
+func (r *Registry) match(text string, filter func(Region) bool) *Region {
+	var (
+		result  *Region
+		longest int
+	)
+
+	for i, region := range r.Regions {
+		if !filter(region) {
+			continue
+		}
+
+		if length := region.matchLength(text); length > longest {
+			result, longest = &r.Regions[i], length
+		}
+	}
+
+	return result
+}
+
+// Matches returns true if the region is mentioned in text by its Ukrainian name or an alias,
+// either as is or as a hashtag.
+func (r Region) Matches(text string) bool {
+	return r.matchLength(text) > 0
+}
+
+// matchLength returns length of the longest name of region mentioned in text, or 0 if none.
+func (r Region) matchLength(text string) int {
+	longest := 0
+
+	for _, name := range append([]string{r.Names["uk"]}, r.Aliases...) {
+		if strings.Contains(text, name) || strings.Contains(text, "#"+strings.ReplaceAll(name, " ", "_")) {
+			if len(name) > longest {
+				longest = len(name)
+			}
+		}
+	}
+
+	return longest
+}
+
+// Migrate makes the list of states and districts in updaterState match the registry.
+// Alert statuses of regions that are still present in the registry are preserved.
+func (r *Registry) Migrate(updaterState *UpdaterSnapshot) error {
+	if updaterState.RegistryVersion > r.Version {
+		return fmt.Errorf(
+			"registry: state was migrated to registry v%d which is newer than v%d",
+			updaterState.RegistryVersion, r.Version,
+		)
+	}
+
+	oldVersion := updaterState.RegistryVersion
+	added, removed := r.apply(updaterState)
+
+	if oldVersion != r.Version {
+		log.Infof(
+			"registry: migrate state from v%d to v%d: %d regions added, %d removed",
+			oldVersion, r.Version, added, removed,
+		)
+	} else if added > 0 || removed > 0 {
+		log.Warnf("registry: regions changed without version bump: %d added, %d removed", added, removed)
+	}
+
+	return nil
+}
+
+// NewSnapshot returns a snapshot with all regions of the registry and no active alerts.
+func (r *Registry) NewSnapshot() *UpdaterSnapshot {
+	snapshot := &UpdaterSnapshot{}
+	r.apply(snapshot)
+
+	return snapshot
+}
+
+func (r *Registry) apply(updaterState *UpdaterSnapshot) (added int, removed int) {
+	kept := 0
+	states := []State{}
+
+	for _, stateRegion := range r.Children(0) {
+		state := State{ID: stateRegion.ID, Alerts: []AlertType{}}
+		if old := updaterState.FindState(stateRegion.ID); old != nil {
+			state = *old
+			kept++
+		} else {
+			added++
+		}
+
+		state.Name = stateRegion.Names["uk"]
+		state.NameEn = stateRegion.Names["en"]
+		districts := []District{}
+
+		for _, districtRegion := range r.Children(stateRegion.ID) {
+			district := District{ID: districtRegion.ID, Alerts: []AlertType{}}
+			if old := state.FindDistrict(districtRegion.ID); old != nil {
+				district = *old
+				kept++
+			} else {
+				added++
+			}
+
+			district.Name = districtRegion.Names["uk"]
+			district.NameEn = districtRegion.Names["en"]
+			districts = append(districts, district)
+		}
+
+		state.Districts = districts
+		states = append(states, state)
+	}
+
+	removed = -kept
+
+	for _, state := range updaterState.States {
+		removed += 1 + len(state.Districts)
+	}
+
+	updaterState.States = states
+	updaterState.RegistryVersion = r.Version
+
+	return added, removed
+}
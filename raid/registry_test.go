@@ -0,0 +1,35 @@
+package raid
+
+import "testing"
+
+func TestRegistryMatchDistrict(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text  string
+		state string
+	}{
+		{"Повітряна тривога в Кам’янець-Подільський район", "Хмельницька область"},
+		{"Повітряна тривога в Подільський район", "Одеська область"},
+		{"Повітряна тривога в Могилів-Подільський район", "Вінницька область"},
+		{"Повітряна тривога в Білгород-Дністровський район", "Одеська область"},
+		{"Повітряна тривога в Дністровський район", "Чернівецька область"},
+		{"Відбій тривоги в #Кам’янець-Подільський_район", "Хмельницька область"},
+	}
+
+	for _, test := range tests {
+		district := registry.MatchDistrict(test.text)
+		if district == nil {
+			t.Errorf("%q: no district found", test.text)
+
+			continue
+		}
+
+		if state := registry.Find(district.Parent); state.Names["uk"] != test.state {
+			t.Errorf("%q: got %s (%s), want district of %s", test.text, district.Names["uk"], state.Names["uk"], test.state)
+		}
+	}
+}
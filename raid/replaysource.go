@@ -0,0 +1,136 @@
+package raid
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ReplaySource is a Source that emits messages from recorded t.me/s/<channel> responses.
+// Messages are emitted in order of their IDs. With speed > 0, the original gaps between
+// messages are reproduced, divided by speed. With speed = 0, all messages are emitted at once.
+type ReplaySource struct {
+	messages []Message
+	speed    float64
+	start    time.Time
+	once     sync.Once
+}
+
+// LoadRecordedPages reads recorded responses from path, which can be either a single file
+// or a directory with *.json files, and returns their messages ordered by ID without duplicates.
+func LoadRecordedPages(path string) ([]Message, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("replaysource: stat %s: %w", path, err)
+	}
+
+	filenames := []string{path}
+
+	if info.IsDir() {
+		if filenames, err = filepath.Glob(filepath.Join(path, "*.json")); err != nil {
+			return nil, fmt.Errorf("replaysource: list %s: %w", path, err)
+		}
+	}
+
+	byID := map[int64]Message{}
+
+	for _, filename := range filenames {
+		pageMessages, err := LoadRecordedPage(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range pageMessages {
+			byID[message.ID] = message
+		}
+	}
+
+	messages := make([]Message, 0, len(byID))
+	for _, message := range byID {
+		messages = append(messages, message)
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID < messages[j].ID
+	})
+
+	return messages, nil
+}
+
+// LoadRecordedPage reads messages from a single recorded response.
+func LoadRecordedPage(filename string) ([]Message, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("replaysource: open %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	root, err := DecodePage(f)
+	if err != nil {
+		return nil, fmt.Errorf("replaysource: decode %s: %w", filename, err)
+	}
+
+	messages, err := ParseMessages(root)
+	if err != nil {
+		return nil, fmt.Errorf("replaysource: parse %s: %w", filename, err)
+	}
+
+	return messages, nil
+}
+
+func NewReplaySource(path string, speed float64) (*ReplaySource, error) {
+	messages, err := LoadRecordedPages(path)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("replaysource: load %d messages from %s", len(messages), path)
+
+	return &ReplaySource{
+		messages: messages,
+		speed:    speed,
+	}, nil
+}
+
+// released returns messages whose replay time has come.
+func (r *ReplaySource) released() []Message {
+	r.once.Do(func() {
+		r.start = time.Now()
+	})
+
+	if r.speed <= 0 || len(r.messages) == 0 {
+		return r.messages
+	}
+
+	elapsed := time.Duration(float64(time.Since(r.start)) * r.speed)
+	first := r.messages[0].Date
+
+	count := sort.Search(len(r.messages), func(i int) bool {
+		return r.messages[i].Date.Sub(first) > elapsed
+	})
+
+	return r.messages[:count]
+}
+
+// FetchLast returns no messages: every recorded message is emitted as fresh by FetchNewer.
+func (r *ReplaySource) FetchLast(ctx context.Context, count int) ([]Message, error) {
+	return []Message{}, nil
+}
+
+func (r *ReplaySource) FetchNewer(ctx context.Context, after int64) ([]Message, error) {
+	result := []Message{}
+
+	for _, message := range r.released() {
+		if message.ID > after {
+			result = append(result, message)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,162 @@
+package raid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadRecordedPages(t *testing.T) {
+	messages, err := LoadRecordedPages("testdata/captures")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pages overlap, since they were recorded by consecutive requests.
+	if len(messages) != 8 {
+		t.Fatalf("got %d messages, want 8", len(messages))
+	}
+
+	for i, message := range messages {
+		if want := int64(1001 + i); message.ID != want {
+			t.Errorf("message %d: got ID %d, want %d", i, message.ID, want)
+		}
+	}
+
+	first := messages[0]
+	if first.Author != "Повітряна Тривога" || len(first.Text) != 3 || first.Text[1] != "Повітряна тривога в Львівська область" {
+		t.Errorf("unexpected first message: %v", first)
+	}
+
+	if want := time.Date(2022, 10, 18, 7, 7, 36, 0, time.UTC); !first.Date.Equal(want) {
+		t.Errorf("got date %s, want %s", first.Date, want)
+	}
+}
+
+func TestReplayCaptures(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := NewReplaySource("testdata/captures", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	updaterState := NewUpdaterState()
+	updater := NewUpdater(source, registry, time.UTC, 200, updaterState)
+
+	messages, err := source.FetchNewer(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updater.ProcessMessages(ctx, messages, true)
+
+	snapshot := updaterState.Snapshot()
+
+	// The last message is not an alert, so it only advances the message ID.
+	if snapshot.LastMessageID != 1008 || snapshot.LastEventID != 7 {
+		t.Errorf("got last message ID %d and event ID %d, want 1008 and 7", snapshot.LastMessageID, snapshot.LastEventID)
+	}
+
+	findState := func(name string) *State {
+		for i, state := range snapshot.States {
+			if state.Name == name {
+				return &snapshot.States[i]
+			}
+		}
+
+		t.Fatalf("state %s not found", name)
+
+		return nil
+	}
+
+	for _, name := range []string{"Львівська область", "Київська область", "Херсонська область"} {
+		state := findState(name)
+		if len(state.Alerts) != 0 || state.Changed == nil {
+			t.Errorf("%s: got alerts %v changed at %v, want all-clear", name, state.Alerts, state.Changed)
+		}
+	}
+
+	if changed := findState("Львівська область").Changed; !changed.Equal(time.Date(2022, 10, 18, 7, 40, 51, 0, time.UTC)) {
+		t.Errorf("got Lviv oblast changed at %s", changed)
+	}
+
+	khmelnytskyi := findState("Хмельницька область")
+	if khmelnytskyi.Alert {
+		t.Error("alert in a district must not affect its state")
+	}
+
+	alerts := []string{}
+
+	for _, district := range khmelnytskyi.Districts {
+		if district.HasAlert(AlertAirRaid) {
+			alerts = append(alerts, district.Name)
+		}
+	}
+
+	if len(alerts) != 1 || alerts[0] != "Кам’янець-Подільський район" {
+		t.Errorf("got alerts in districts %v, want Кам’янець-Подільський район", alerts)
+	}
+}
+
+func TestRecordSkipsUnchangedPages(t *testing.T) {
+	pages := []string{}
+
+	for _, name := range []string{"1666077000000000000.json", "1666080000000000000.json"} {
+		page, err := os.ReadFile(filepath.Join("testdata/captures", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages = append(pages, string(page))
+	}
+
+	// The first page is served twice, e.g. when nothing was posted between polls.
+	responses := []string{pages[0], pages[0], pages[1]}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(responses[0]))
+		responses = responses[1:]
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewChannelClient("test")
+	client.RecordTo(dir)
+
+	for range []int{1, 2, 3} {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := client.fetchAndParse(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(filenames) != 2 {
+		t.Fatalf("got %d recorded pages, want 2", len(filenames))
+	}
+
+	messages, err := LoadRecordedPages(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 8 {
+		t.Errorf("got %d replayed messages, want 8", len(messages))
+	}
+}
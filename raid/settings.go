@@ -10,20 +10,46 @@ import (
 )
 
 type Settings struct {
-	TelegramChannel string         `env:"TELEGRAM_CHANNEL" envDefault:"air_alert_ua" yaml:"telegram_channel"`
-	TimezoneName    string         `env:"TZ" envDefault:"Europe/Kiev" yaml:"timezone_name"`
-	Timezone        *time.Location ``
-	APIKeys         []string       `env:"API_KEYS" envSeparator:"," envDefault:"" yaml:"api_keys"`
-	Debug           bool           `env:"DEBUG" envDefault:"false" yaml:"debug"`
-	Trace           bool           `env:"TRACE" envDefault:"false" yaml:"trace"`
-	BacklogSize     int            `env:"BACKLOG_SIZE" envDefault:"200" yaml:"backlog_size"`
+	Source           string         `env:"SOURCE" envDefault:"telegram" yaml:"source"`
+	TelegramChannel  string         `env:"TELEGRAM_CHANNEL" envDefault:"air_alert_ua" yaml:"telegram_channel"`
+	RecordDir        string         `env:"RECORD_DIR" envDefault:"" yaml:"record_dir"`
+	PushPort         uint16         `env:"PUSH_PORT" envDefault:"10102" yaml:"push_port"`
+	MetricsPort      uint16         `env:"METRICS_PORT" envDefault:"10103" yaml:"metrics_port"`
+	ReplayPath       string         `env:"REPLAY_PATH" envDefault:"" yaml:"replay_path"`
+	ReplaySpeed      float64        `env:"REPLAY_SPEED" envDefault:"0" yaml:"replay_speed"`
+	TimezoneName     string         `env:"TZ" envDefault:"Europe/Kiev" yaml:"timezone_name"`
+	Timezone         *time.Location ``
+	APIKeys          []string       `env:"API_KEYS" envSeparator:"," envDefault:"" yaml:"api_keys"`
+	AdminKeys        []string       `env:"ADMIN_KEYS" envSeparator:"," envDefault:"" yaml:"admin_keys"`
+	Debug            bool           `env:"DEBUG" envDefault:"false" yaml:"debug"`
+	Trace            bool           `env:"TRACE" envDefault:"false" yaml:"trace"`
+	BacklogSize      int            `env:"BACKLOG_SIZE" envDefault:"200" yaml:"backlog_size"`
+	RegistryPath     string         `env:"REGISTRY_PATH" envDefault:"" yaml:"registry_path"`
+	AutosaveUpdates  int            `env:"AUTOSAVE_UPDATES" envDefault:"20" yaml:"autosave_updates"`
+	AutosaveInterval time.Duration  `env:"AUTOSAVE_INTERVAL" envDefault:"30s" yaml:"autosave_interval"`
+	StaleThreshold   time.Duration  `env:"STALE_THRESHOLD" envDefault:"2m" yaml:"stale_threshold"`
+	TLSPort          uint16         `env:"TLS_PORT" envDefault:"1025" yaml:"tls_port"`
+	TLSCertFile      string         `env:"TLS_CERT_FILE" envDefault:"" yaml:"tls_cert_file"`
+	TLSKeyFile       string         `env:"TLS_KEY_FILE" envDefault:"" yaml:"tls_key_file"`
+	TLSClientCAFile  string         `env:"TLS_CLIENT_CA_FILE" envDefault:"" yaml:"tls_client_ca_file"`
+	AnnounceAddr     string         `env:"ANNOUNCE_ADDR" envDefault:"" yaml:"announce_addr"`
+	AnnounceSecret   string         `env:"ANNOUNCE_SECRET" envDefault:"" yaml:"announce_secret"`
+	AnnounceInterval time.Duration  `env:"ANNOUNCE_INTERVAL" envDefault:"10s" yaml:"announce_interval"`
 }
 
 func MustLoadSettings() (settings Settings) {
 	var err error
 
+	settings.Source = "telegram"
 	settings.TimezoneName = "Europe/Kiev"
 	settings.TelegramChannel = "air_alert_ua"
+	settings.PushPort = 10102
+	settings.MetricsPort = 10103
+	settings.AutosaveUpdates = 20
+	settings.AutosaveInterval = 30 * time.Second
+	settings.StaleThreshold = 2 * time.Minute
+	settings.TLSPort = 1025
+	settings.AnnounceInterval = 10 * time.Second
 
 	if len(os.Args) > 1 {
 		var f *os.File
@@ -55,12 +81,70 @@ func MustLoadSettings() (settings Settings) {
 		log.Fatalf("settings: load timezone: %s", err)
 	}
 
+	if settings.AutosaveInterval <= 0 {
+		log.Fatal("settings: autosave interval must be positive")
+	}
+
+	if (settings.TLSCertFile == "") != (settings.TLSKeyFile == "") {
+		log.Fatal("settings: both TLS certificate and key files must be set to enable TLS")
+	}
+
+	if settings.TLSClientCAFile != "" && settings.TLSCertFile == "" {
+		log.Fatal("settings: client CA file requires TLS certificate and key files")
+	}
+
+	if settings.AnnounceAddr != "" && settings.AnnounceSecret == "" {
+		log.Fatal("settings: announce secret must be set to announce updates")
+	}
+
+	if settings.AnnounceInterval <= 0 {
+		log.Fatal("settings: announce interval must be positive")
+	}
+
+	settings.APIKeys = withoutEmpty(settings.APIKeys)
+	settings.AdminKeys = withoutEmpty(settings.AdminKeys)
+
+	// More keys can be issued with "raid keys" command or admin API.
 	if len(settings.APIKeys) == 0 {
-		log.Fatal("settings: no API keys were loaded")
+		log.Warn("settings: no static API keys were loaded")
 	}
 
-	log.Infof("settings: load %d API keys", len(settings.APIKeys))
-	log.Infof("settings: %v", settings)
+	log.Infof("settings: load %d API keys, %d admin keys", len(settings.APIKeys), len(settings.AdminKeys))
+	log.Infof("settings: %v", settings.redacted())
 
 	return
 }
+
+// redacted returns a copy of settings with secrets masked, so that it can be logged.
+func (s Settings) redacted() Settings {
+	s.APIKeys = masked(s.APIKeys)
+	s.AdminKeys = masked(s.AdminKeys)
+
+	if s.AnnounceSecret != "" {
+		s.AnnounceSecret = "***"
+	}
+
+	return s
+}
+
+func masked(values []string) []string {
+	result := make([]string, len(values))
+
+	for i := range values {
+		result[i] = "***"
+	}
+
+	return result
+}
+
+func withoutEmpty(values []string) []string {
+	result := []string{}
+
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,28 @@
+package raid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSettingsRedacted(t *testing.T) {
+	settings := Settings{
+		APIKeys:        []string{"api-key"},
+		AdminKeys:      []string{"admin-key"},
+		AnnounceSecret: "announce-secret",
+	}
+
+	logged := fmt.Sprintf("%+v", settings.redacted())
+
+	for _, secret := range []string{"api-key", "admin-key", "announce-secret"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("%q is logged: %s", secret, logged)
+		}
+	}
+
+	// Original settings are still used by the app.
+	if settings.APIKeys[0] != "api-key" || settings.AdminKeys[0] != "admin-key" {
+		t.Errorf("settings were changed: %+v", settings)
+	}
+}
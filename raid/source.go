@@ -0,0 +1,12 @@
+package raid
+
+import "context"
+
+// Source provides channel messages to the Updater.
+// Both methods must return messages ordered from oldest to newest.
+type Source interface {
+	// FetchLast returns at least count latest messages, if available.
+	FetchLast(ctx context.Context, count int) ([]Message, error)
+	// FetchNewer returns all messages with ID greater than after.
+	FetchNewer(ctx context.Context, after int64) ([]Message, error)
+}
@@ -15,12 +15,22 @@ func NewSSEEncoder(w http.ResponseWriter) *SSEEncoder {
 }
 
 func (e *SSEEncoder) Write(event string, data interface{}) error {
+	return e.WriteWithID("", event, data)
+}
+
+// WriteWithID writes an event with "id" field, which clients send back in Last-Event-ID header when reconnecting.
+func (e *SSEEncoder) WriteWithID(id string, event string, data interface{}) error {
 	encoded, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("sse: encode event data: %w", err)
 	}
 
-	if _, err := e.writer.Write([]byte(fmt.Sprintf("event: %s\r\ndata: %s\r\n\r\n", event, encoded))); err != nil {
+	message := fmt.Sprintf("event: %s\r\ndata: %s\r\n\r\n", event, encoded)
+	if id != "" {
+		message = fmt.Sprintf("id: %s\r\n%s", id, message)
+	}
+
+	if _, err := e.writer.Write([]byte(message)); err != nil {
 		return fmt.Errorf("sse: write event data: %w", err)
 	}
 
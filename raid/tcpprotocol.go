@@ -0,0 +1,512 @@
+package raid
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/and3rson/raid/raid/binproto"
+)
+
+// Latest version of TCP protocol. Clients request a version in handshake, e.g. "v2,<key>,<region IDs>...",
+// and server uses the highest version it supports, which is not newer than requested.
+// Handshake without version, i.e. "<key>[,<state ID>[,<alert type>]]", is version 1.
+// Version 3 also accepts commands from client, see handleCommand.
+const tcpProtocolVersion = 3
+
+// Handshake prefix of binary framing, e.g. "b1,<key>,<region IDs>...", see binproto package.
+const tcpBinaryFraming = "b1"
+
+// Auth errors sent to TCP clients as "a:<error>" and command errors sent as "e:<command>,<error>".
+var (
+	errTCPTimeout        = errors.New("timeout")
+	errTCPWrongAPIKey    = errors.New("wrong_api_key")
+	errTCPWrongRegion    = errors.New("wrong_region")
+	errTCPUnknownCommand = errors.New("unknown_command")
+	errTCPIdleTimeout    = errors.New("idle_timeout")
+	errTCPLineTooLong    = errors.New("line_too_long")
+)
+
+// tcpSession holds parameters of a TCP client connection negotiated during handshake.
+// Subscription is read by topic filter and modified by client commands concurrently.
+type tcpSession struct {
+	version int
+	binary  bool
+	key     string
+	// Only updates of this alert type are sent in version 1.
+	alertType AlertType
+	// all is true if subscribed to all states, otherwise regions holds IDs of subscribed states or districts.
+	all     bool
+	regions map[int]bool
+	mutex   sync.Mutex
+}
+
+// parseTCPHandshake parses the first packet sent by client.
+// Session is returned even if handshake is invalid, so that the error is sent in negotiated framing.
+func parseTCPHandshake(data string) (*tcpSession, error) {
+	parts := strings.Split(strings.TrimSpace(data), ",")
+
+	version, ok := parseTCPVersion(parts[0])
+	binary := parts[0] == tcpBinaryFraming
+
+	// A single value is always a key, even if it looks like a version.
+	if (!ok && !binary) || len(parts) == 1 {
+		return parseTCPHandshakeV1(parts)
+	}
+
+	if version == 1 {
+		return parseTCPHandshakeV1(parts[1:])
+	}
+
+	// Binary framing has no commands, so it carries contents of version 2.
+	if binary {
+		version = 2
+	}
+
+	session := &tcpSession{version: version, binary: binary, key: parts[1], regions: map[int]bool{}}
+
+	ids, err := parseTCPRegionIDs(parts[2:])
+	if err != nil {
+		return session, err
+	}
+
+	session.subscribe(ids)
+
+	return session, nil
+}
+
+func parseTCPVersion(value string) (int, bool) {
+	if !strings.HasPrefix(value, "v") {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(value[1:])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+
+	if version > tcpProtocolVersion {
+		version = tcpProtocolVersion
+	}
+
+	return version, true
+}
+
+func parseTCPHandshakeV1(parts []string) (*tcpSession, error) {
+	session := &tcpSession{version: 1, key: parts[0], alertType: AlertAirRaid, all: true, regions: map[int]bool{}}
+
+	// Invalid region ID means all regions, as it always did.
+	if len(parts) > 1 {
+		if id, _ := strconv.Atoi(parts[1]); id != 0 {
+			session.subscribe([]int{id})
+		}
+	}
+
+	// Unknown alert type and anything after it are ignored, since old devices may send extra fields.
+	if len(parts) > 2 {
+		if alertType, err := ParseAlertType(parts[2]); err == nil {
+			session.alertType = alertType
+		}
+	}
+
+	return session, nil
+}
+
+func parseTCPRegionIDs(values []string) ([]int, error) {
+	ids := []int{}
+
+	for _, idStr := range values {
+		id, err := strconv.Atoi(strings.TrimSpace(idStr))
+		if err != nil || id <= 0 {
+			return nil, errTCPWrongRegion
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// validateTCPRegions checks that regions exist and are allowed for the key.
+func validateTCPRegions(snapshot *UpdaterSnapshot, apiKey *APIKey, ids []int) error {
+	for _, id := range ids {
+		stateID := id
+
+		if state, district := snapshot.FindDistrict(id); district != nil {
+			stateID = state.ID
+		} else if snapshot.FindState(id) == nil {
+			return errTCPWrongRegion
+		}
+
+		if !apiKey.Allows(stateID) {
+			return errTCPWrongRegion
+		}
+	}
+
+	return nil
+}
+
+// validate checks regions requested in handshake.
+func (s *tcpSession) validate(snapshot *UpdaterSnapshot, apiKey *APIKey) error {
+	// Version 1 never rejected region IDs.
+	if s.version == 1 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	ids := make([]int, 0, len(s.regions))
+
+	for id := range s.regions {
+		ids = append(ids, id)
+	}
+	s.mutex.Unlock()
+
+	return validateTCPRegions(snapshot, apiKey, ids)
+}
+
+// subscribe adds regions to subscription, or subscribes to all states if ids are empty.
+func (s *tcpSession) subscribe(ids []int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(ids) == 0 {
+		s.all = true
+		s.regions = map[int]bool{}
+
+		return
+	}
+
+	s.all = false
+
+	for _, id := range ids {
+		s.regions[id] = true
+	}
+}
+
+// unsubscribe removes regions from subscription, or all of them if ids are empty.
+// Unsubscribing from a region while subscribed to all states subscribes to the rest of them.
+func (s *tcpSession) unsubscribe(snapshot *UpdaterSnapshot, ids []int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(ids) == 0 {
+		s.all = false
+		s.regions = map[int]bool{}
+
+		return
+	}
+
+	if s.all {
+		s.all = false
+
+		for _, state := range snapshot.States {
+			s.regions[state.ID] = true
+		}
+	}
+
+	for _, id := range ids {
+		delete(s.regions, id)
+	}
+}
+
+func (s *tcpSession) isSubscribed(id int) bool {
+	return s.all || s.regions[id]
+}
+
+// describe returns "all", "none" or comma-separated IDs of subscribed regions.
+func (s *tcpSession) describe() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.all {
+		return "all"
+	}
+
+	if len(s.regions) == 0 {
+		return "none"
+	}
+
+	ids := make([]int, 0, len(s.regions))
+	for id := range s.regions {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ","), "[]")
+}
+
+func (s *tcpSession) authOK() string {
+	if s.binary {
+		return string(binproto.Encode(binproto.Auth{Status: binproto.AuthOK}))
+	}
+
+	if s.version == 1 {
+		return "a:ok\n"
+	}
+
+	return fmt.Sprintf("a:ok,v%d\n", s.version)
+}
+
+func (s *tcpSession) authError(err error) string {
+	if !s.binary {
+		return fmt.Sprintf("a:%s\n", err)
+	}
+
+	// Any other error must not look like success to the client.
+	status := binproto.AuthWrongAPIKey
+
+	switch {
+	case errors.Is(err, errTCPTimeout):
+		status = binproto.AuthTimeout
+	case errors.Is(err, errTCPWrongRegion):
+		status = binproto.AuthWrongRegion
+	}
+
+	return string(binproto.Encode(binproto.Auth{Status: status}))
+}
+
+func (s *tcpSession) matches(u Update) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.version == 1 {
+		return u.District == nil && u.AlertType == s.alertType && s.isSubscribed(u.State.ID)
+	}
+
+	if u.District != nil {
+		return s.regions[u.District.ID]
+	}
+
+	return s.isSubscribed(u.State.ID)
+}
+
+// formatSnapshot returns packets with current states of subscribed regions.
+// Version 2 sends a packet for every alert type, tagged with the last event ID.
+// Binary framing sends states as a bitmap per alert type instead.
+func (s *tcpSession) formatSnapshot(snapshot *UpdaterSnapshot, apiKey *APIKey) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	packets := []string{}
+
+	if s.binary {
+		packets = append(packets, s.formatBitmaps(snapshot, apiKey)...)
+	}
+
+	for _, state := range snapshot.States {
+		if !apiKey.Allows(state.ID) {
+			continue
+		}
+
+		if s.version == 1 {
+			if s.isSubscribed(state.ID) {
+				packets = append(packets, fmt.Sprintf("s:%d=%d\n", state.ID, boolToInt(state.HasAlert(s.alertType))))
+			}
+
+			continue
+		}
+
+		if !s.binary && s.isSubscribed(state.ID) {
+			for _, alertType := range AlertTypes {
+				packets = append(packets, s.formatState(
+					snapshot.LastEventID, state.ID, alertType, state.HasAlert(alertType), state.Changed,
+				))
+			}
+		}
+
+		for _, district := range state.Districts {
+			if !s.regions[district.ID] {
+				continue
+			}
+
+			for _, alertType := range AlertTypes {
+				packets = append(packets, s.formatState(
+					snapshot.LastEventID, district.ID, alertType, district.HasAlert(alertType), district.Changed,
+				))
+			}
+		}
+	}
+
+	return packets
+}
+
+// formatBitmaps returns binary state frames of subscribed states, one for every alert type.
+func (s *tcpSession) formatBitmaps(snapshot *UpdaterSnapshot, apiKey *APIKey) []string {
+	include := func(id int) bool {
+		return apiKey.Allows(id) && s.isSubscribed(id)
+	}
+
+	packets := []string{}
+
+	for _, frame := range binaryBitmaps(snapshot, include) {
+		packets = append(packets, string(binproto.Encode(frame)))
+	}
+
+	return packets
+}
+
+func (s *tcpSession) formatUpdate(u Update) string {
+	if s.version == 1 {
+		return fmt.Sprintf("s:%d=%d\n", u.State.ID, boolToInt(u.State.HasAlert(s.alertType)))
+	}
+
+	if u.District != nil {
+		return s.formatState(u.EventID, u.District.ID, u.AlertType, u.District.HasAlert(u.AlertType), u.District.Changed)
+	}
+
+	return s.formatState(u.EventID, u.State.ID, u.AlertType, u.State.HasAlert(u.AlertType), u.State.Changed)
+}
+
+// formatPing returns ping packet, which contains a random number in version 1,
+// or server time and whether data is degraded since version 2.
+func (s *tcpSession) formatPing(random int, degraded bool) string {
+	if s.binary {
+		return string(binproto.Encode(binproto.Ping{Time: uint32(time.Now().Unix()), Degraded: degraded}))
+	}
+
+	if s.version == 1 {
+		return fmt.Sprintf("p:%d\n", random)
+	}
+
+	return fmt.Sprintf("p:%d,%d\n", time.Now().Unix(), boolToInt(degraded))
+}
+
+// handleCommand executes a command line sent by client and returns packets to send in reply,
+// which are "r:<command>[,<data>]" on success or "e:<command>,<error>" on failure:
+//
+//	sub [<IDs>]    subscribe to regions, or to all states if IDs are omitted; replies with current subscription
+//	unsub [<IDs>]  unsubscribe from regions, or from everything if IDs are omitted; replies with current subscription
+//	snapshot       send current states of subscribed regions again
+//	ping           reply with server time and whether data is degraded
+//	quit           close connection after reply
+//
+// IDs are separated by commas or spaces. Quit is true if connection should be closed.
+func (s *tcpSession) handleCommand(
+	line string, snapshot *UpdaterSnapshot, apiKey *APIKey, degraded bool,
+) (packets []string, quit bool) {
+	fields := strings.FieldsFunc(line, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\r'
+	})
+
+	// Empty lines are ignored, so that clients may send them to keep connection alive.
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	command, args := fields[0], fields[1:]
+
+	switch command {
+	case "sub", "unsub":
+		ids, err := parseTCPRegionIDs(args)
+		if err == nil {
+			err = validateTCPRegions(snapshot, apiKey, ids)
+		}
+
+		if err != nil {
+			return []string{fmt.Sprintf("e:%s,%s\n", command, err)}, false
+		}
+
+		if command == "sub" {
+			s.subscribe(ids)
+		} else {
+			s.unsubscribe(snapshot, ids)
+		}
+
+		return []string{fmt.Sprintf("r:%s,%s\n", command, s.describe())}, false
+	case "snapshot":
+		return append(s.formatSnapshot(snapshot, apiKey), "r:snapshot\n"), false
+	case "ping":
+		return []string{fmt.Sprintf("r:ping,%d,%d\n", time.Now().Unix(), boolToInt(degraded))}, false
+	case "quit":
+		return []string{"r:quit\n"}, true
+	default:
+		return []string{fmt.Sprintf("e:%s,%s\n", command, errTCPUnknownCommand)}, false
+	}
+}
+
+// formatState returns state packet since version 2: "s:<event ID>,<region ID>,<alert type>,<0|1>,<changed at>",
+// where change time is Unix timestamp, or 0 if unknown. Binary framing sends change frame with the same fields.
+func (s *tcpSession) formatState(
+	eventID int64, regionID int, alertType AlertType, alert bool, changed *time.Time,
+) string {
+	if s.binary {
+		return string(binproto.Encode(binaryChange(eventID, regionID, alertType, alert, changed)))
+	}
+
+	var changedAt int64
+	if changed != nil {
+		changedAt = changed.Unix()
+	}
+
+	return fmt.Sprintf("s:%d,%d,%s,%d,%d\n", eventID, regionID, alertType, boolToInt(alert), changedAt)
+}
+
+// binaryBitmaps returns state frames of all alert types, where only states accepted by include may have alerts.
+func binaryBitmaps(snapshot *UpdaterSnapshot, include func(id int) bool) []binproto.State {
+	maxID := 0
+
+	for _, state := range snapshot.States {
+		if state.ID > maxID {
+			maxID = state.ID
+		}
+	}
+
+	frames := []binproto.State{}
+
+	for _, alertType := range AlertTypes {
+		alerts := make([]bool, maxID)
+
+		for _, state := range snapshot.States {
+			if include(state.ID) {
+				alerts[state.ID-1] = state.HasAlert(alertType)
+			}
+		}
+
+		frames = append(frames, binproto.State{
+			EventID:   uint32(snapshot.LastEventID),
+			AlertType: binaryAlertType(alertType),
+			Alerts:    alerts,
+		})
+	}
+
+	return frames
+}
+
+func binaryChange(eventID int64, regionID int, alertType AlertType, alert bool, changed *time.Time) binproto.Change {
+	var changedAt int64
+	if changed != nil {
+		changedAt = changed.Unix()
+	}
+
+	return binproto.Change{
+		EventID:   uint32(eventID),
+		RegionID:  uint16(regionID),
+		AlertType: binaryAlertType(alertType),
+		Alert:     alert,
+		ChangedAt: uint32(changedAt),
+	}
+}
+
+// binaryAlertType returns code of alert type in binary framing, which is its index in binproto.AlertTypes.
+func binaryAlertType(alertType AlertType) uint8 {
+	for i, name := range binproto.AlertTypes {
+		if name == string(alertType) {
+			return uint8(i)
+		}
+	}
+
+	return 0
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
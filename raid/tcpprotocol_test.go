@@ -0,0 +1,92 @@
+package raid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/and3rson/raid/raid/binproto"
+)
+
+func TestParseTCPHandshakeV1(t *testing.T) {
+	tests := []struct {
+		handshake string
+		key       string
+		all       bool
+		alertType AlertType
+	}{
+		{"key", "key", true, AlertAirRaid},
+		{"key\r\n", "key", true, AlertAirRaid},
+		{"key,12", "key", false, AlertAirRaid},
+		{"key,0", "key", true, AlertAirRaid},
+		{"key,abc", "key", true, AlertAirRaid},
+		{"key,12,artillery", "key", false, AlertArtillery},
+		// Old devices may send extra fields, which are ignored.
+		{"key,12,1", "key", false, AlertAirRaid},
+		{"key,12,", "key", false, AlertAirRaid},
+		{"key,0,nuclear,extra", "key", true, AlertNuclear},
+		// A single value is always a key.
+		{"v2", "v2", true, AlertAirRaid},
+	}
+
+	for _, test := range tests {
+		session, err := parseTCPHandshake(test.handshake)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.handshake, err)
+
+			continue
+		}
+
+		if session.version != 1 || session.key != test.key || session.all != test.all || session.alertType != test.alertType {
+			t.Errorf(
+				"%q: got version %d, key %q, all %v, alert type %s", test.handshake, session.version, session.key, session.all,
+				session.alertType,
+			)
+		}
+	}
+}
+
+func TestBinaryAlertTypes(t *testing.T) {
+	names := []string{}
+	for _, alertType := range AlertTypes {
+		names = append(names, string(alertType))
+	}
+
+	// Codes of alert types are their indices, so new types may only be appended to both lists.
+	if !reflect.DeepEqual(names, binproto.AlertTypes) {
+		t.Errorf("binproto.AlertTypes = %v, want %v", binproto.AlertTypes, names)
+	}
+}
+
+func TestTCPAuthErrorBinary(t *testing.T) {
+	session, err := parseTCPHandshake(tcpBinaryFraming + ",key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		err    error
+		status binproto.AuthStatus
+	}{
+		{errTCPTimeout, binproto.AuthTimeout},
+		{errTCPWrongAPIKey, binproto.AuthWrongAPIKey},
+		{errTCPWrongRegion, binproto.AuthWrongRegion},
+		// Unexpected errors must not be reported as success.
+		{errTCPUnknownCommand, binproto.AuthWrongAPIKey},
+		{errors.New("other"), binproto.AuthWrongAPIKey},
+	}
+
+	for _, test := range tests {
+		frame, err := binproto.Decode(strings.NewReader(session.authError(test.err)))
+		if err != nil {
+			t.Errorf("%v: %v", test.err, err)
+
+			continue
+		}
+
+		if auth, ok := frame.(binproto.Auth); !ok || auth.Status != test.status {
+			t.Errorf("%v: got %+v, want status %d", test.err, frame, test.status)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package raid
 
 import (
+	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,22 +20,63 @@ import (
 
 type TCPServer struct {
 	port         uint16
-	apiKeys      []string
+	keys         *KeyStore
+	usage        *Usage
 	updaterState *UpdaterState
 	updates      *Topic[Update]
+	// TLS listener is only started if certificates are configured.
+	tlsPort      uint16
+	certReloader *CertReloader
+	// Version 2 pings tell clients if data is degraded.
+	staleThreshold time.Duration
+	// Names of listeners which accept connections, "tcp" and "tls".
+	listening map[string]bool
+	mutex     sync.Mutex
 }
 
-func NewTCPServer(port uint16, apiKeys []string, updaterState *UpdaterState, updates *Topic[Update]) *TCPServer {
+const (
+	// Usage of all TCP connections is accounted under this endpoint.
+	tcpEndpoint = "tcp"
+	// Clients that send commands must send something at least this often, e.g. ping command or an empty line.
+	tcpIdleTimeout = time.Minute
+	tcpMaxLineSize = 1024
+	// Handshake of TLS connections may take long for microcontrollers on slow links, e.g. RSA on ESP8266 over GSM.
+	tcpTLSHandshakeTimeout = 30 * time.Second
+)
+
+// usageConn counts bytes written to connection.
+type usageConn struct {
+	net.Conn
+	usage   *Usage
+	keyHash string
+}
+
+func (c *usageConn) Write(data []byte) (int, error) {
+	n, err := c.Conn.Write(data)
+	c.usage.AddBytes(c.keyHash, tcpEndpoint, n)
+
+	return n, err //nolint:wrapcheck
+}
+
+func NewTCPServer(
+	port uint16, tlsPort uint16, certReloader *CertReloader, keys *KeyStore, usage *Usage, updaterState *UpdaterState,
+	updates *Topic[Update], staleThreshold time.Duration,
+) *TCPServer {
 	return &TCPServer{
-		port:         port,
-		apiKeys:      apiKeys,
-		updaterState: updaterState,
-		updates:      updates,
+		port:           port,
+		tlsPort:        tlsPort,
+		certReloader:   certReloader,
+		keys:           keys,
+		usage:          usage,
+		updaterState:   updaterState,
+		updates:        updates,
+		staleThreshold: staleThreshold,
+		listening:      map[string]bool{},
 	}
 }
 
 func (t *TCPServer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
-	defer log.Debug("mapgenerator: exit")
+	defer log.Debug("tcpserver: exit")
 
 	defer wg.Done()
 	wg.Add(1)
@@ -51,13 +96,82 @@ func (t *TCPServer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan erro
 		return
 	}
 
-	defer l.Close()
+	listeners := map[string]net.Listener{"tcp": l}
+
+	// TLS listener serves the same protocol.
+	if t.certReloader != nil {
+		tlsListener, err := cfg.Listen(ctx, "tcp", fmt.Sprintf("0.0.0.0:%d", t.tlsPort))
+		if err != nil {
+			l.Close()
+			errch <- fmt.Errorf("tcpserver: listen TLS: %w", err)
+
+			return
+		}
+
+		listeners["tls"] = tls.NewListener(tlsListener, t.certReloader.TLSConfig())
+	}
 
 	go func() {
 		<-ctx.Done()
-		l.Close()
+
+		for _, l := range listeners {
+			l.Close()
+		}
 	}()
 
+	accepting := &sync.WaitGroup{}
+
+	for name, l := range listeners {
+		accepting.Add(1)
+
+		go func(name string, l net.Listener) {
+			defer accepting.Done()
+
+			t.setListening(name, true)
+			defer t.setListening(name, false)
+
+			t.accept(ctx, l, errch)
+		}(name, l)
+	}
+
+	accepting.Wait()
+}
+
+func (t *TCPServer) setListening(name string, listening bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.listening[name] = listening
+}
+
+func (t *TCPServer) checkListening(name string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !t.listening[name] {
+		return fmt.Errorf("tcpserver: %s listener is not accepting connections", name)
+	}
+
+	return nil
+}
+
+// Ping fails if plain TCP listener is not accepting connections.
+func (t *TCPServer) Ping(ctx context.Context) error {
+	return t.checkListening("tcp")
+}
+
+// PingTLS fails if TLS listener is not accepting connections or its certificate has expired.
+func (t *TCPServer) PingTLS(ctx context.Context) error {
+	if err := t.checkListening("tls"); err != nil {
+		return err
+	}
+
+	return t.certReloader.Ping(ctx)
+}
+
+func (t *TCPServer) accept(ctx context.Context, l net.Listener, errch chan error) {
+	defer l.Close()
+
 	for {
 		conn, err := l.Accept()
 		if err != nil {
@@ -74,103 +188,220 @@ func (t *TCPServer) Run(ctx context.Context, wg *sync.WaitGroup, errch chan erro
 	}
 }
 
+// clientCertKeyID returns ID of API key from verified client certificate, whose common name must be the ID.
+func clientCertKeyID(conn net.Conn) (int, bool) {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return 0, false
+	}
+
+	chains := tlsConn.ConnectionState().VerifiedChains
+	if len(chains) == 0 {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(chains[0][0].Subject.CommonName)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+
+	return id, true
+}
+
 func (t *TCPServer) HandleConn(ctx context.Context, conn net.Conn) {
 	defer conn.Close()
 
+	// TLS handshake gets its own timeout, so that it does not use up the time to send handshake line.
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		handshakeCtx, cancel := context.WithTimeout(ctx, tcpTLSHandshakeTimeout)
+		err := tlsConn.HandshakeContext(handshakeCtx)
+
+		cancel()
+
+		if err != nil {
+			log.Debugf("tcpserver: TLS handshake with %s: %v", conn.RemoteAddr(), err)
+
+			return
+		}
+	}
+
+	certKeyID, hasCert := clientCertKeyID(conn)
+
 	if err := conn.SetReadDeadline(time.Now().Add(time.Second * 3)); err != nil {
 		log.Errorf("tcpserver: set deadline: %v", err)
 
 		return
 	}
 
-	buf := make([]byte, 128)
+	// Handshake is read with a single read, since clients may not end it with a line break.
+	// Devices with client certificates may send nothing, which is the same as an empty line.
+	buf := make([]byte, 1024)
 
 	n, err := conn.Read(buf)
-	if err != nil {
+	if err != nil && !(hasCert && errors.Is(err, os.ErrDeadlineExceeded)) {
 		log.Errorf("tcpserver: read auth: %v", err)
 
-		_, _ = conn.Write([]byte("a:timeout\n"))
+		// Framing is not known yet, so the error is always sent as text.
+		_, _ = conn.Write([]byte(fmt.Sprintf("a:%s\n", errTCPTimeout)))
 
 		return
 	}
 
-	parts := strings.Split(strings.TrimSpace(string(buf[:n])), ",")
-	apiKey := parts[0]
+	// Version 3 clients may send commands right after handshake, they are read along with it.
+	handshake, pending, _ := strings.Cut(string(buf[:n]), "\n")
+
+	session, err := parseTCPHandshake(handshake)
+	if err != nil {
+		_, _ = conn.Write([]byte(session.authError(err)))
 
-	id := 0
-	if len(parts) > 1 {
-		id, _ = strconv.Atoi(parts[1])
+		return
 	}
 
-	authSuccess := false
+	credentials := session.key
+	lookup := func() *APIKey {
+		return t.keys.Lookup(session.key)
+	}
 
-	for _, other := range t.apiKeys {
-		if other == apiKey {
-			authSuccess = true
+	// Devices with client certificates may omit the key from handshake.
+	if hasCert && session.key == "" {
+		credentials = fmt.Sprintf("certificate of key %d", certKeyID)
+		lookup = func() *APIKey {
+			return t.keys.LookupByID(certKeyID)
 		}
 	}
 
-	log.Debugf("tcpserver: client auth success: %v, key: %v", authSuccess, apiKey)
+	key := lookup()
+
+	log.Debugf("tcpserver: client auth success: %v, key: %v, version: %d", key != nil, credentials, session.version)
+
+	// Key is checked periodically, so that clients are disconnected when it's revoked or restricted.
+	isCurrent := func() bool {
+		current := lookup()
+
+		return current != nil && current.UpdatedAt.Equal(key.UpdatedAt)
+	}
+
+	if key == nil {
+		_, _ = conn.Write([]byte(session.authError(errTCPWrongAPIKey)))
+
+		return
+	}
+
+	snapshot := t.updaterState.Snapshot()
 
-	if !authSuccess {
-		_, _ = conn.Write([]byte("a:wrong_api_key\n"))
+	if err := session.validate(snapshot, key); err != nil {
+		_, _ = conn.Write([]byte(session.authError(err)))
 
 		return
 	}
 
-	if _, err := conn.Write([]byte("a:ok\n")); err != nil {
+	t.usage.AddRequest(key.Hash, tcpEndpoint, false)
+
+	connection := t.usage.Connect(key.Hash, tcpEndpoint)
+	defer t.usage.Disconnect(connection)
+
+	conn = &usageConn{conn, t.usage, key.Hash}
+
+	if _, err := conn.Write([]byte(session.authOK())); err != nil {
 		log.Errorf("tcpserver: write auth success: %v", err)
 
 		return
 	}
 
-	for _, state := range t.updaterState.States {
-		if id == 0 || id == state.ID {
-			alert := 0
-			if state.Alert {
-				alert = 1
-			}
-
-			if _, err := conn.Write([]byte(fmt.Sprintf("s:%d=%d\n", state.ID, alert))); err != nil {
-				log.Errorf("tcpserver: write state: %v", err)
+	for _, packet := range session.formatSnapshot(snapshot, key) {
+		if _, err := conn.Write([]byte(packet)); err != nil {
+			log.Errorf("tcpserver: write state: %v", err)
 
-				return
-			}
+			return
 		}
 	}
 
-	events := t.updates.Subscribe("tcpserver-"+conn.RemoteAddr().String(), func(u Update) bool {
-		return u.IsFresh && (id == 0 || id == u.State.ID)
+	events := t.updates.Subscribe("tcpserver-"+conn.RemoteAddr().String(), OverflowDisconnect, func(u Update) bool {
+		return u.IsFresh && key.Allows(u.State.ID) && session.matches(u)
 	})
 
 	defer func() {
 		t.updates.Unsubscribe(events)
 	}()
 
+	done := make(chan struct{})
+	defer close(done)
+
+	commands := make(chan string)
+	readErrors := make(chan error, 1)
+
+	// Older clients never send anything after handshake, so they are not expected to keep connection alive.
+	if session.version >= 3 {
+		go readTCPCommands(conn, io.MultiReader(strings.NewReader(pending), conn), commands, readErrors, done)
+	}
+
+	keysChanged := t.keys.Changed()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event, ok := <-events:
-			if !ok {
+		case line := <-commands:
+			snapshot := t.updaterState.Snapshot()
+			packets, quit := session.handleCommand(line, snapshot, key, snapshot.Stale(t.staleThreshold))
+
+			conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
+			for _, packet := range packets {
+				if _, err := conn.Write([]byte(packet)); err != nil {
+					log.Errorf("tcpserver: write reply: %v", err)
+
+					return
+				}
+			}
+
+			if quit {
+				log.Debugf("tcpserver: client quit %s", conn.RemoteAddr())
+
 				return
 			}
+		case err := <-readErrors:
+			var netErr net.Error
+
+			switch {
+			case errors.Is(err, bufio.ErrTooLong):
+				writeTCPError(conn, "read", errTCPLineTooLong)
+			case errors.As(err, &netErr) && netErr.Timeout():
+				writeTCPError(conn, "read", errTCPIdleTimeout)
+			}
+
+			log.Debugf("tcpserver: read command from %s: %v", conn.RemoteAddr(), err)
 
-			alert := 0
+			return
+		case <-keysChanged:
+			if !isCurrent() {
+				log.Debugf("tcpserver: API key was changed, disconnect %s", conn.RemoteAddr())
+
+				return
+			}
 
-			if event.State.Alert {
-				alert = 1
+			keysChanged = t.keys.Changed()
+		case event, ok := <-events:
+			if !ok {
+				return
 			}
 
 			conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
-			if _, err := conn.Write([]byte(fmt.Sprintf("s:%d=%d\n", event.State.ID, alert))); err != nil {
+			if _, err := conn.Write([]byte(session.formatUpdate(event))); err != nil {
 				log.Errorf("tcpserver: write state: %v", err)
 
 				return
 			}
 		case <-time.After(time.Second * 15):
+			if !isCurrent() {
+				log.Debugf("tcpserver: API key was changed, disconnect %s", conn.RemoteAddr())
+
+				return
+			}
+
+			ping := session.formatPing(rand.Intn(10000), t.updaterState.Snapshot().Stale(t.staleThreshold))
+
 			conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
-			if _, err := conn.Write([]byte(fmt.Sprintf("p:%d\n", rand.Intn(10000)))); err != nil {
+			if _, err := conn.Write([]byte(ping)); err != nil {
 				log.Errorf("tcpserver: write ping: %v", err)
 
 				return
@@ -178,3 +409,41 @@ func (t *TCPServer) HandleConn(ctx context.Context, conn net.Conn) {
 		}
 	}
 }
+
+func writeTCPError(conn net.Conn, command string, err error) {
+	conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
+	_, _ = conn.Write([]byte(fmt.Sprintf("e:%s,%s\n", command, err)))
+}
+
+// readTCPCommands reads command lines until connection fails or client sends nothing for tcpIdleTimeout.
+func readTCPCommands(
+	conn net.Conn, reader io.Reader, commands chan<- string, readErrors chan<- error, done <-chan struct{},
+) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, tcpMaxLineSize), tcpMaxLineSize)
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			readErrors <- err
+
+			return
+		}
+
+		if !scanner.Scan() {
+			err := scanner.Err()
+			if err == nil {
+				err = io.EOF
+			}
+
+			readErrors <- err
+
+			return
+		}
+
+		select {
+		case commands <- scanner.Text():
+		case <-done:
+			return
+		}
+	}
+}
@@ -3,15 +3,20 @@ package raid
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/andybalholm/cascadia"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/html"
 )
@@ -24,15 +29,18 @@ var TextSel = cascadia.MustCompile(".tgme_widget_message_text")
 var DateSel = cascadia.MustCompile(".tgme_widget_message_footer time[datetime]")
 
 type ChannelClient struct {
-	client  *http.Client
-	channel string
+	client    *http.Client
+	channel   string
+	recordDir string
+	// Hash of the last recorded response, pages are polled often but rarely change.
+	lastRecorded [sha256.Size]byte
 }
 
 type Message struct {
-	ID     int64
-	Author string
-	Text   []string
-	Date   time.Time
+	ID     int64     `json:"id"`
+	Author string    `json:"author"`
+	Text   []string  `json:"text"`
+	Date   time.Time `json:"date"`
 }
 
 func (m Message) String() string {
@@ -46,9 +54,17 @@ func NewChannelClient(channel string) *ChannelClient {
 	return &ChannelClient{
 		&http.Client{},
 		channel,
+		"",
+		[sha256.Size]byte{},
 	}
 }
 
+// RecordTo makes the client save raw responses into dir so that they can be replayed with ReplaySource.
+// Responses which are the same as the previous one are skipped.
+func (c *ChannelClient) RecordTo(dir string) {
+	c.recordDir = dir
+}
+
 func getText(node *html.Node) string {
 	return strings.Join(getLines(node), " ")
 }
@@ -85,10 +101,47 @@ func (c *ChannelClient) fetchAndParse(req *http.Request) (*html.Node, error) {
 	if err != nil {
 		return nil, fmt.Errorf("telegram: post request: %w", err)
 	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("telegram: read response: %w", err)
+	}
+
+	if c.recordDir != "" {
+		c.record(body)
+	}
+
+	return DecodePage(bytes.NewReader(body))
+}
+
+func (c *ChannelClient) record(body []byte) {
+	hash := sha256.Sum256(body)
+	if hash == c.lastRecorded {
+		return
+	}
+
+	if err := os.MkdirAll(c.recordDir, 0o755); err != nil {
+		log.Errorf("telegram: create record directory: %v", err)
+
+		return
+	}
+
+	filename := filepath.Join(c.recordDir, fmt.Sprintf("%d.json", time.Now().UnixNano()))
+	if err := os.WriteFile(filename, body, 0o644); err != nil {
+		log.Errorf("telegram: record response: %v", err)
+
+		return
+	}
+
+	c.lastRecorded = hash
+}
 
+// DecodePage parses a JSON-wrapped HTML page as returned by t.me/s/<channel>.
+func DecodePage(r io.Reader) (*html.Node, error) {
 	var data string
 
-	decoder := json.NewDecoder(resp.Body)
+	decoder := json.NewDecoder(r)
 	if err := decoder.Decode(&data); err != nil {
 		return nil, fmt.Errorf("telegram: decode response: %w", err)
 	}
@@ -101,8 +154,37 @@ func (c *ChannelClient) fetchAndParse(req *http.Request) (*html.Node, error) {
 	return root, nil
 }
 
-func (c *ChannelClient) FetchMessages(ctx context.Context, before int64) ([]Message, error) {
+// ParseMessages extracts messages from a page decoded with DecodePage.
+func ParseMessages(root *html.Node) ([]Message, error) {
 	messages := []Message{}
+
+	nodes := MessagesSel.MatchAll(root)
+	for _, node := range nodes {
+		authorNode := AuthorSel.MatchFirst(node)
+		textNode := TextSel.MatchFirst(node)
+		dateNode := DateSel.MatchFirst(node)
+		dateTimeNode := getAttr(dateNode, "datetime")
+		dataPost := getAttr(node, "data-post")
+		parts := strings.Split(dataPost, "/")
+
+		id, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("telegram: parse message ID: %w", err)
+		}
+
+		datetime, err := time.Parse(time.RFC3339, dateTimeNode)
+		if err != nil {
+			return nil, fmt.Errorf("telegram: parse message time: %w", err)
+		}
+		// Note: datetime is in UTC without timezone here
+
+		messages = append(messages, Message{id, getText(authorNode), getLines(textNode), datetime})
+	}
+
+	return messages, nil
+}
+
+func (c *ChannelClient) FetchMessages(ctx context.Context, before int64) ([]Message, error) {
 	url := fmt.Sprintf(URLPattern, c.channel)
 
 	if before != 0 {
@@ -123,10 +205,16 @@ func (c *ChannelClient) FetchMessages(ctx context.Context, before int64) ([]Mess
 	var root *html.Node
 
 	for {
-		if root, err = c.fetchAndParse(req); err == nil {
+		timer := prometheus.NewTimer(scrapeDuration)
+		root, err = c.fetchAndParse(req)
+		timer.ObserveDuration()
+
+		if err == nil {
 			break
 		}
 
+		scrapeErrors.Inc()
+
 		if errors.Is(err, context.Canceled) {
 			return nil, err
 		}
@@ -140,30 +228,7 @@ func (c *ChannelClient) FetchMessages(ctx context.Context, before int64) ([]Mess
 		}
 	}
 
-	nodes := MessagesSel.MatchAll(root)
-	for _, node := range nodes {
-		authorNode := AuthorSel.MatchFirst(node)
-		textNode := TextSel.MatchFirst(node)
-		dateNode := DateSel.MatchFirst(node)
-		dateTimeNode := getAttr(dateNode, "datetime")
-		dataPost := getAttr(node, "data-post")
-		parts := strings.Split(dataPost, "/")
-
-		id, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf("telegram: parse message ID: %w", err)
-		}
-
-		datetime, err := time.Parse(time.RFC3339, dateTimeNode)
-		if err != nil {
-			return nil, fmt.Errorf("telegram: parse message time: %w", err)
-		}
-		// Note: datetime is in UTC without timezone here
-
-		messages = append(messages, Message{id, getText(authorNode), getLines(textNode), datetime})
-	}
-
-	return messages, nil
+	return ParseMessages(root)
 }
 
 func (c *ChannelClient) FetchLast(ctx context.Context, count int) ([]Message, error) {
@@ -10,71 +10,71 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-type Updater struct {
-	telegramChannel string
-	timezone        *time.Location
-	backlogSize     int
-	updaterState    *UpdaterState
-	Updates         *Topic[Update]
-}
+// Number of last updates kept in memory for clients that resume their subscriptions.
+const updatesHistorySize = 1000
 
-type UpdaterState struct {
-	States        []State   `json:"states"`
-	LastUpdate    time.Time `json:"last_update"`
-	LastMessageID int64     `json:"last_message_id"`
+type Updater struct {
+	source       Source
+	registry     *Registry
+	timezone     *time.Location
+	backlogSize  int
+	updaterState *UpdaterState
+	Updates      *Topic[Update]
 }
 
 type State struct {
-	ID      int        `json:"id"`
-	Name    string     `json:"name"`
-	NameEn  string     `json:"name_en"`
-	Alert   bool       `json:"alert"`
-	Changed *time.Time `json:"changed"`
+	ID        int         `json:"id"`
+	Name      string      `json:"name"`
+	NameEn    string      `json:"name_en"`
+	Alert     bool        `json:"alert"`
+	Alerts    []AlertType `json:"alerts"`
+	Changed   *time.Time  `json:"changed"`
+	Districts []District  `json:"districts,omitempty"`
 }
 
+// Update describes a change of a state or, if District is not nil, of one of its districts.
 type Update struct {
-	IsFresh bool
-	IsLast  bool
-	State   State
+	EventID   int64
+	MessageID int64
+	IsFresh   bool
+	IsLast    bool
+	AlertType AlertType
+	State     State
+	District  *District
 }
 
-func NewUpdater(telegramChannel string, timezone *time.Location, backlogSize int, updaterState *UpdaterState) *Updater {
-	if len(updaterState.States) == 0 {
-		updaterState.States = []State{
-			{1, "Вінницька область", "Vinnytsia oblast", false, nil},
-			{2, "Волинська область", "Volyn oblast", false, nil},
-			{3, "Дніпропетровська область", "Dnipropetrovsk oblast", false, nil},
-			{4, "Донецька область", "Donetsk oblast", false, nil},
-			{5, "Житомирська область", "Zhytomyr oblast", false, nil},
-			{6, "Закарпатська область", "Zakarpattia oblast", false, nil},
-			{7, "Запорізька область", "Zaporizhzhia oblast", false, nil},
-			{8, "Івано-Франківська область", "Ivano-Frankivsk oblast", false, nil},
-			{9, "Київська область", "Kyiv oblast", false, nil},
-			{10, "Кіровоградська область", "Kirovohrad oblast", false, nil},
-			{11, "Луганська область", "Luhansk oblast", false, nil},
-			{12, "Львівська область", "Lviv oblast", false, nil},
-			{13, "Миколаївська область", "Mykolaiv oblast", false, nil},
-			{14, "Одеська область", "Odesa oblast", false, nil},
-			{15, "Полтавська область", "Poltava oblast", false, nil},
-			{16, "Рівненська область", "Rivne oblast", false, nil},
-			{17, "Сумська область", "Sumy oblast", false, nil},
-			{18, "Тернопільська область", "Ternopil oblast", false, nil},
-			{19, "Харківська область", "Kharkiv oblast", false, nil},
-			{20, "Херсонська область", "Kherson oblast", false, nil},
-			{21, "Хмельницька область", "Khmelnytskyi oblast", false, nil},
-			{22, "Черкаська область", "Cherkasy oblast", false, nil},
-			{23, "Чернівецька область", "Chernivtsi oblast", false, nil},
-			{24, "Чернігівська область", "Chernihiv oblast", false, nil},
-			{25, "м. Київ", "Kyiv", false, nil},
+func NewUpdater(
+	source Source, registry *Registry, timezone *time.Location, backlogSize int, updaterState *UpdaterState,
+) *Updater {
+	if err := updaterState.Update(func(data *UpdaterSnapshot) error {
+		if err := registry.Migrate(data); err != nil {
+			return err
+		}
+
+		// States persisted before alert types were introduced only have the Alert flag.
+		for i := range data.States {
+			state := &data.States[i]
+			if state.Alerts == nil {
+				state.Alerts = []AlertType{}
+			}
+
+			if state.Alert && !state.HasAlert(AlertAirRaid) {
+				state.SetAlert(AlertAirRaid, true)
+			}
 		}
+
+		return nil
+	}); err != nil {
+		log.Fatalf("updater: %s", err)
 	}
 
 	return &Updater{
-		telegramChannel,
+		source,
+		registry,
 		timezone,
 		backlogSize,
 		updaterState,
-		NewTopic[Update](),
+		NewTopicWithHistory[Update](updatesHistorySize),
 	}
 }
 
@@ -84,14 +84,12 @@ func (u *Updater) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error)
 	defer wg.Done()
 	wg.Add(1)
 
-	cc := NewChannelClient(u.telegramChannel)
-
 	var wait <-chan time.Time
 
-	if u.updaterState.LastMessageID == 0 {
+	if lastMessageID := u.updaterState.Snapshot().LastMessageID; lastMessageID == 0 {
 		log.Infof("updater: no previous ID, will fetch backlog")
 
-		messages, err := cc.FetchLast(ctx, u.backlogSize)
+		messages, err := u.source.FetchLast(ctx, u.backlogSize)
 		if err != nil {
 			errch <- fmt.Errorf("updater: fetch initial batch: %w", err)
 
@@ -99,15 +97,12 @@ func (u *Updater) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error)
 		}
 
 		log.Infof("updater: fetch %d last messages", len(messages))
-		u.updaterState.LastMessageID = messages[len(messages)-1].ID
 
 		u.ProcessMessages(ctx, messages, false)
 
-		u.updaterState.LastUpdate = time.Now().In(u.timezone)
-
 		wait = time.After(2 * time.Second)
 	} else {
-		log.Infof("updater: continue from ID %d", u.updaterState.LastMessageID)
+		log.Infof("updater: continue from ID %d", lastMessageID)
 
 		wait = time.After(0)
 	}
@@ -119,7 +114,7 @@ func (u *Updater) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error)
 		case <-wait:
 		}
 
-		messages, err := cc.FetchNewer(ctx, u.updaterState.LastMessageID)
+		messages, err := u.source.FetchNewer(ctx, u.updaterState.Snapshot().LastMessageID)
 		if err != nil {
 			log.Error(err)
 
@@ -128,65 +123,46 @@ func (u *Updater) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error)
 			continue
 		}
 
-		u.updaterState.LastUpdate = time.Now().In(u.timezone)
-
 		if len(messages) > 0 {
 			log.Infof("updater: fetch %d new messages", len(messages))
-			u.updaterState.LastMessageID = messages[len(messages)-1].ID
-			u.ProcessMessages(ctx, messages, true)
 
 			wait = time.After(0)
 		} else {
 			wait = time.After(2 * time.Second)
 		}
+
+		u.ProcessMessages(ctx, messages, true)
 	}
 }
 
+// ProcessMessages applies messages to the state as a single batch and broadcasts resulting updates.
 func (u *Updater) ProcessMessages(ctx context.Context, messages []Message, isFresh bool) {
 	updates := []Update{}
 
-	for _, msg := range messages {
-		var (
-			on    bool
-			state *State
-		)
-
-		if len(msg.Text) < 2 {
-			log.Debugf("updater: not enough text in message: %v", msg.Text)
+	_ = u.updaterState.Update(func(data *UpdaterSnapshot) error {
+		data.LastUpdate = time.Now().In(u.timezone)
 
-			continue
+		if len(messages) > 0 {
+			data.LastMessageID = messages[len(messages)-1].ID
 		}
 
-		sentence := msg.Text[1]
-
-		switch {
-		case strings.Contains(sentence, "Повітряна тривога"):
-			on = true
-		case strings.Contains(sentence, "Відбій"):
-			on = false
-		default:
-			log.Errorf("updater: don't know how to parse \"%s\"", sentence)
-		}
+		for _, msg := range messages {
+			update := u.processMessage(data, msg, isFresh)
+			if update == nil {
+				processedMessages.WithLabelValues("unparsed").Inc()
 
-		for index, other := range u.updaterState.States {
-			if strings.Contains(sentence, other.Name) {
-				state = &u.updaterState.States[index]
+				continue
 			}
-		}
 
-		if state == nil {
-			log.Debugf("updater: no known states found in \"%s\"", sentence)
-		} else {
-			t := msg.Date.In(u.timezone)
-			state.Changed = &t
-			state.Alert = on
-			log.Debugf("updater: new state: %s (id=%d) -> %v", state.Name, state.ID, on)
-			updates = append(updates, Update{
-				IsFresh: isFresh,
-				State:   *state,
-			})
+			processedMessages.WithLabelValues("parsed").Inc()
+
+			data.LastEventID++
+			update.EventID = data.LastEventID
+			updates = append(updates, *update)
 		}
-	}
+
+		return nil
+	})
 
 	for i, update := range updates {
 		if i == len(updates)-1 {
@@ -197,10 +173,103 @@ func (u *Updater) ProcessMessages(ctx context.Context, messages []Message, isFre
 	}
 }
 
-func (s *UpdaterState) FindState(id int) *State {
-	for i, state := range s.States {
-		if state.ID == id {
-			return &s.States[i]
+// SkipEventIDs makes sure that new events get IDs greater than lastEventID,
+// e.g. when the persisted state is older than the history.
+func (u *Updater) SkipEventIDs(lastEventID int64) {
+	_ = u.updaterState.Update(func(data *UpdaterSnapshot) error {
+		if data.LastEventID < lastEventID {
+			log.Warnf("updater: skip event IDs from %d to %d", data.LastEventID, lastEventID)
+			data.LastEventID = lastEventID
+		}
+
+		return nil
+	})
+}
+
+func (u *Updater) processMessage(data *UpdaterSnapshot, msg Message, isFresh bool) *Update {
+	if len(msg.Text) < 2 {
+		log.Debugf("updater: not enough text in message: %v", msg.Text)
+
+		return nil
+	}
+
+	sentence := msg.Text[1]
+
+	alertType, on, err := ParseAlert(sentence)
+	if err != nil {
+		log.Errorf("updater: don't know how to parse message: %v", err)
+
+		return nil
+	}
+
+	t := msg.Date.In(u.timezone)
+
+	if region := u.registry.MatchDistrict(strings.Join(msg.Text, " ")); region != nil {
+		if state, district := data.FindDistrict(region.ID); district != nil {
+			district.Changed = &t
+			district.SetAlert(alertType, on)
+			log.Debugf("updater: new district state: %s (id=%d) -> %s=%v", district.Name, district.ID, alertType, on)
+
+			districtCopy := *district
+
+			return &Update{
+				MessageID: msg.ID,
+				IsFresh:   isFresh,
+				AlertType: alertType,
+				State:     state.WithoutDistricts(),
+				District:  &districtCopy,
+			}
+		}
+	}
+
+	var state *State
+
+	if region := u.registry.MatchState(sentence); region != nil {
+		state = data.FindState(region.ID)
+	}
+
+	if state == nil {
+		log.Debugf("updater: no known states found in \"%s\"", sentence)
+
+		return nil
+	}
+
+	state.Changed = &t
+	state.SetAlert(alertType, on)
+	log.Debugf("updater: new state: %s (id=%d) -> %s=%v", state.Name, state.ID, alertType, on)
+
+	return &Update{
+		MessageID: msg.ID,
+		IsFresh:   isFresh,
+		AlertType: alertType,
+		State:     state.WithoutDistricts(),
+	}
+}
+
+func (s State) HasAlert(alertType AlertType) bool {
+	return hasAlert(s.Alerts, alertType)
+}
+
+// SetAlert activates or clears an alert of the given type. Alert mirrors the air raid alert.
+func (s *State) SetAlert(alertType AlertType, on bool) {
+	s.Alerts = setAlert(s.Alerts, alertType, on)
+
+	if alertType == AlertAirRaid {
+		s.Alert = on
+	}
+}
+
+// WithoutDistricts returns a copy of the state suitable for state-level API payloads.
+func (s State) WithoutDistricts() State {
+	s.Districts = nil
+
+	return s
+}
+
+func (s *State) FindDistrict(id int) *District {
+	for i, district := range s.Districts {
+		if district.ID == id {
+			return &s.Districts[i]
 		}
 	}
 
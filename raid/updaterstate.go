@@ -0,0 +1,159 @@
+package raid
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// UpdaterState is a concurrency-safe store of states.
+// Readers get immutable snapshots, writers apply whole batches of changes atomically.
+type UpdaterState struct {
+	mutex    sync.RWMutex
+	writes   sync.Mutex
+	snapshot *UpdaterSnapshot
+}
+
+// UpdaterSnapshot is a consistent view of UpdaterState.
+// Snapshots returned by UpdaterState.Snapshot are shared between readers and must not be modified.
+type UpdaterSnapshot struct {
+	States          []State   `json:"states"`
+	LastUpdate      time.Time `json:"last_update"`
+	LastMessageID   int64     `json:"last_message_id"`
+	LastEventID     int64     `json:"last_event_id"`
+	RegistryVersion int       `json:"registry_version"`
+	Version         uint64    `json:"version"`
+}
+
+func NewUpdaterState() *UpdaterState {
+	return &UpdaterState{}
+}
+
+func (s *UpdaterState) Snapshot() *UpdaterSnapshot {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.snapshot == nil {
+		return &UpdaterSnapshot{States: []State{}}
+	}
+
+	return s.snapshot
+}
+
+// Update applies fn to a private copy of the current snapshot and, if fn succeeds,
+// publishes it as the new snapshot. Version is only incremented if fn changed anything but LastUpdate,
+// so that empty polls don't make the state look modified.
+func (s *UpdaterState) Update(fn func(data *UpdaterSnapshot) error) error {
+	s.writes.Lock()
+	defer s.writes.Unlock()
+
+	current := s.Snapshot()
+
+	data := current.clone()
+	if err := fn(data); err != nil {
+		return err
+	}
+
+	if !data.sameAs(current) {
+		data.Version++
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.snapshot = data
+
+	return nil
+}
+
+func (s *UpdaterState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(s.Snapshot())
+	if err != nil {
+		return nil, fmt.Errorf("updaterstate: encode: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *UpdaterState) UnmarshalJSON(data []byte) error {
+	snapshot := &UpdaterSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return fmt.Errorf("updaterstate: decode: %w", err)
+	}
+
+	s.writes.Lock()
+	defer s.writes.Unlock()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.snapshot = snapshot
+
+	return nil
+}
+
+// clone returns a copy that can be modified without affecting readers of the original.
+// Alerts slices are shared since SetAlert never modifies them in place.
+func (d *UpdaterSnapshot) clone() *UpdaterSnapshot {
+	result := *d
+	result.States = make([]State, len(d.States))
+
+	for i, state := range d.States {
+		if state.Districts != nil {
+			state.Districts = append([]District{}, state.Districts...)
+		}
+
+		result.States[i] = state
+	}
+
+	return &result
+}
+
+// sameAs reports whether snapshots differ only in LastUpdate.
+func (d *UpdaterSnapshot) sameAs(other *UpdaterSnapshot) bool {
+	a, b := *d, *other
+	a.LastUpdate, b.LastUpdate = time.Time{}, time.Time{}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// ApplyRecord replays a historical record onto the snapshot.
+func (d *UpdaterSnapshot) ApplyRecord(record Record) {
+	state := d.FindState(record.StateID)
+	if state == nil {
+		return
+	}
+
+	date := record.Date
+
+	if district := state.FindDistrict(record.DistrictID); district != nil {
+		district.Changed = &date
+		district.SetAlert(record.AlertType, record.Alert)
+	} else {
+		state.Changed = &date
+		state.SetAlert(record.AlertType, record.Alert)
+	}
+}
+
+func (d *UpdaterSnapshot) FindState(id int) *State {
+	for i, state := range d.States {
+		if state.ID == id {
+			return &d.States[i]
+		}
+	}
+
+	return nil
+}
+
+// FindDistrict returns district and its parent state.
+func (d *UpdaterSnapshot) FindDistrict(id int) (*State, *District) {
+	for i := range d.States {
+		if district := d.States[i].FindDistrict(id); district != nil {
+			return &d.States[i], district
+		}
+	}
+
+	return nil, nil
+}
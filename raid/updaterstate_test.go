@@ -0,0 +1,151 @@
+package raid
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestUpdaterStateConcurrentAccess(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updaterState := NewUpdaterState()
+	if err := updaterState.Update(func(data *UpdaterSnapshot) error {
+		*data = *registry.NewSnapshot()
+
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		writers = 4
+		readers = 8
+		writes  = 200
+	)
+
+	wg := &sync.WaitGroup{}
+	done := make(chan struct{})
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < writes; j++ {
+				// All regions are changed in a single batch, so readers must never see them differ.
+				_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+					alert := !data.States[0].Alert
+
+					for s := range data.States {
+						state := &data.States[s]
+						state.SetAlert(AlertAirRaid, alert)
+
+						for d := range state.Districts {
+							state.Districts[d].SetAlert(AlertAirRaid, alert)
+						}
+					}
+
+					data.LastEventID++
+
+					return nil
+				})
+			}
+		}()
+	}
+
+	readersWG := &sync.WaitGroup{}
+
+	for i := 0; i < readers; i++ {
+		readersWG.Add(1)
+
+		go func() {
+			defer readersWG.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				snapshot := updaterState.Snapshot()
+				before, _ := json.Marshal(snapshot)
+
+				for _, state := range snapshot.States {
+					if state.Alert != snapshot.States[0].Alert || state.HasAlert(AlertAirRaid) != state.Alert {
+						t.Errorf("version %d: inconsistent state %d", snapshot.Version, state.ID)
+
+						return
+					}
+
+					for _, district := range state.Districts {
+						if district.HasAlert(AlertAirRaid) != state.Alert {
+							t.Errorf("version %d: inconsistent district %d", snapshot.Version, district.ID)
+
+							return
+						}
+					}
+				}
+
+				// Snapshots must not be modified after they are returned, even though writers keep working.
+				if after, _ := json.Marshal(snapshot); string(before) != string(after) {
+					t.Errorf("version %d: snapshot was modified", snapshot.Version)
+
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	readersWG.Wait()
+
+	if lastEventID := updaterState.Snapshot().LastEventID; lastEventID != writers*writes {
+		t.Errorf("got last event ID %d, want %d", lastEventID, writers*writes)
+	}
+}
+
+func TestUpdaterStateVersion(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updaterState := NewUpdaterState()
+	_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+		*data = *registry.NewSnapshot()
+
+		return nil
+	})
+
+	version := updaterState.Snapshot().Version
+
+	// Empty poll only refreshes LastUpdate.
+	_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+		data.LastUpdate = time.Now()
+
+		return nil
+	})
+
+	if snapshot := updaterState.Snapshot(); snapshot.Version != version || snapshot.LastUpdate.IsZero() {
+		t.Errorf("got version %d after empty poll, want %d with new last update", snapshot.Version, version)
+	}
+
+	_ = updaterState.Update(func(data *UpdaterSnapshot) error {
+		data.LastUpdate = time.Now()
+		data.States[0].SetAlert(AlertAirRaid, true)
+
+		return nil
+	})
+
+	if got := updaterState.Snapshot().Version; got != version+1 {
+		t.Errorf("got version %d after change, want %d", got, version+1)
+	}
+}
@@ -0,0 +1,397 @@
+package raid
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
+)
+
+// How often usage counters are saved to DB, so usage stats are delayed by up to this interval.
+const usageFlushInterval = time.Minute
+
+// UsageCounters is the usage of an endpoint by a key.
+type UsageCounters struct {
+	Requests int64 `json:"requests"`
+	// Requests rejected by rate limiter.
+	Rejected          int64   `json:"rejected"`
+	BytesSent         int64   `json:"bytes_sent"`
+	ConnectionSeconds float64 `json:"connection_seconds"`
+}
+
+func (c *UsageCounters) add(other UsageCounters) {
+	c.Requests += other.Requests
+	c.Rejected += other.Rejected
+	c.BytesSent += other.BytesSent
+	c.ConnectionSeconds += other.ConnectionSeconds
+}
+
+// UsageRecord is the usage of an endpoint by a key during an hour.
+type UsageRecord struct {
+	Hour     time.Time `json:"hour"`
+	Endpoint string    `json:"endpoint"`
+	UsageCounters
+}
+
+// KeyUsage is the total usage by a key over a period.
+type KeyUsage struct {
+	// ID is 0 for static keys from settings, owner is empty for deleted keys.
+	KeyID    int       `json:"key_id"`
+	Owner    string    `json:"owner"`
+	LastSeen time.Time `json:"last_seen"`
+	UsageCounters
+}
+
+type usageKey struct {
+	keyHash  string
+	hour     time.Time
+	endpoint string
+}
+
+// UsageConnection tracks time of a long-lived connection, e.g. SSE or TCP.
+type UsageConnection struct {
+	keyHash  string
+	endpoint string
+	// Connection time is accounted periodically, so that it's split between hours correctly.
+	accountedAt time.Time
+}
+
+// Usage aggregates usage of API keys by hour and endpoint and saves it to DB.
+type Usage struct {
+	db          *sql.DB
+	keys        *KeyStore
+	counters    map[usageKey]*UsageCounters
+	connections map[*UsageConnection]bool
+	mutex       sync.Mutex
+}
+
+func NewUsage(dbname string, keys *KeyStore) *Usage {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("./data/%s.sqlite?_busy_timeout=5000", dbname))
+	if err != nil {
+		log.Fatalf("usage: open DB: %s", err)
+	}
+
+	if err := migrate(context.Background(), db, "usage"); err != nil {
+		log.Fatalf("usage: %s", err)
+	}
+
+	return &Usage{
+		db:          db,
+		keys:        keys,
+		counters:    map[usageKey]*UsageCounters{},
+		connections: map[*UsageConnection]bool{},
+	}
+}
+
+func (u *Usage) counter(keyHash string, endpoint string, now time.Time) *UsageCounters {
+	key := usageKey{keyHash, now.UTC().Truncate(time.Hour), endpoint}
+
+	counters, ok := u.counters[key]
+	if !ok {
+		counters = &UsageCounters{}
+		u.counters[key] = counters
+	}
+
+	return counters
+}
+
+// AddRequest counts a request, rejected is true if it was rejected by rate limiter.
+func (u *Usage) AddRequest(keyHash string, endpoint string, rejected bool) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	counters := u.counter(keyHash, endpoint, time.Now())
+	counters.Requests++
+
+	if rejected {
+		counters.Rejected++
+	}
+}
+
+func (u *Usage) AddBytes(keyHash string, endpoint string, bytes int) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.counter(keyHash, endpoint, time.Now()).BytesSent += int64(bytes)
+}
+
+// Connect starts tracking time of a connection until Disconnect is called.
+func (u *Usage) Connect(keyHash string, endpoint string) *UsageConnection {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	connection := &UsageConnection{keyHash, endpoint, time.Now()}
+	u.connections[connection] = true
+
+	return connection
+}
+
+func (u *Usage) Disconnect(connection *UsageConnection) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.accountConnection(connection, time.Now())
+	delete(u.connections, connection)
+}
+
+func (u *Usage) accountConnection(connection *UsageConnection, now time.Time) {
+	u.counter(connection.keyHash, connection.endpoint, now).ConnectionSeconds += now.Sub(connection.accountedAt).Seconds()
+	connection.accountedAt = now
+}
+
+// Middleware counts requests and bytes sent by keys that requests were authenticated with.
+func (u *Usage) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		writer := &usageWriter{rw, u, requestAPIKey(r).Hash, routeEndpoint(r), 200}
+
+		next.ServeHTTP(writer, r)
+
+		u.AddRequest(writer.keyHash, writer.endpoint, writer.status == http.StatusTooManyRequests)
+	})
+}
+
+var routeVariableRegexp = regexp.MustCompile(`\{(\w+):[^}]+\}`)
+
+// routeEndpoint identifies endpoint by route template, e.g. "/api/states/{id}", to keep the number of endpoints bounded.
+func routeEndpoint(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return routeVariableRegexp.ReplaceAllString(template, "{$1}")
+		}
+	}
+
+	return r.URL.Path
+}
+
+// usageWriter counts bytes as they are written, since SSE responses may last for hours.
+type usageWriter struct {
+	http.ResponseWriter
+	usage    *Usage
+	keyHash  string
+	endpoint string
+	status   int
+}
+
+func (w *usageWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *usageWriter) Write(data []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(data)
+	w.usage.AddBytes(w.keyHash, w.endpoint, n)
+
+	return n, err //nolint:wrapcheck
+}
+
+func (w *usageWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (u *Usage) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("usage: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			// Save what was collected since the last flush before exiting.
+			if err := u.flush(context.Background()); err != nil {
+				log.Error(err)
+			}
+
+			return
+		}
+
+		if err := u.flush(ctx); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// flush saves collected counters to DB. If saving fails, they are kept to be saved next time.
+func (u *Usage) flush(ctx context.Context) error {
+	u.mutex.Lock()
+
+	now := time.Now()
+	for connection := range u.connections {
+		u.accountConnection(connection, now)
+	}
+
+	counters := u.counters
+	u.counters = map[usageKey]*UsageCounters{}
+
+	u.mutex.Unlock()
+
+	if len(counters) == 0 {
+		return nil
+	}
+
+	if err := u.save(ctx, counters); err != nil {
+		u.mutex.Lock()
+		defer u.mutex.Unlock()
+
+		for key, value := range counters {
+			current, ok := u.counters[key]
+			if !ok {
+				u.counters[key] = value
+
+				continue
+			}
+
+			current.add(*value)
+		}
+
+		return err
+	}
+
+	log.Debugf("usage: save %d counters", len(counters))
+
+	return nil
+}
+
+func (u *Usage) save(ctx context.Context, counters map[usageKey]*UsageCounters) error {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("usage: begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO usage (key_hash, hour, endpoint, requests, rejected, bytes_sent, connection_seconds)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (key_hash, hour, endpoint) DO UPDATE SET
+			requests = requests + excluded.requests,
+			rejected = rejected + excluded.rejected,
+			bytes_sent = bytes_sent + excluded.bytes_sent,
+			connection_seconds = connection_seconds + excluded.connection_seconds
+	`)
+	if err != nil {
+		return fmt.Errorf("usage: prepare save: %w", err)
+	}
+	defer stmt.Close()
+
+	for key, value := range counters {
+		if _, err := stmt.ExecContext(
+			ctx, key.keyHash, key.hour, key.endpoint, value.Requests, value.Rejected, value.BytesSent, value.ConnectionSeconds,
+		); err != nil {
+			return fmt.Errorf("usage: save: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("usage: commit: %w", err)
+	}
+
+	return nil
+}
+
+// Query returns hourly usage by a key over a period, ordered by hour and endpoint.
+func (u *Usage) Query(ctx context.Context, keyHash string, from time.Time, to time.Time) ([]UsageRecord, error) {
+	rows, err := u.db.QueryContext(ctx, `
+		SELECT hour, endpoint, requests, rejected, bytes_sent, connection_seconds
+		FROM usage
+		WHERE key_hash = ? AND julianday(hour) >= julianday(?) AND julianday(hour) < julianday(?)
+		ORDER BY julianday(hour), endpoint
+	`, keyHash, from.UTC().Truncate(time.Hour), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("usage: query: %w", err)
+	}
+	defer rows.Close()
+
+	result := []UsageRecord{}
+
+	for rows.Next() {
+		record := UsageRecord{}
+		if err := rows.Scan(
+			&record.Hour, &record.Endpoint, &record.Requests, &record.Rejected, &record.BytesSent, &record.ConnectionSeconds,
+		); err != nil {
+			return nil, fmt.Errorf("usage: scan: %w", err)
+		}
+
+		result = append(result, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("usage: read: %w", err)
+	}
+
+	return result, nil
+}
+
+// Summary returns total usage by every key that was used over a period, most active first.
+func (u *Usage) Summary(ctx context.Context, from time.Time, to time.Time) ([]KeyUsage, error) {
+	rows, err := u.db.QueryContext(ctx, `
+		SELECT key_hash, MAX(hour), SUM(requests), SUM(rejected), SUM(bytes_sent), SUM(connection_seconds)
+		FROM usage
+		WHERE julianday(hour) >= julianday(?) AND julianday(hour) < julianday(?)
+		GROUP BY key_hash
+		ORDER BY SUM(requests) DESC
+	`, from.UTC().Truncate(time.Hour), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("usage: query summary: %w", err)
+	}
+	defer rows.Close()
+
+	result := []KeyUsage{}
+
+	for rows.Next() {
+		var (
+			keyUsage KeyUsage
+			keyHash  string
+			lastSeen string
+		)
+
+		// Aggregates lose column types, so the date is returned as text.
+		if err := rows.Scan(
+			&keyHash, &lastSeen,
+			&keyUsage.Requests, &keyUsage.Rejected, &keyUsage.BytesSent, &keyUsage.ConnectionSeconds,
+		); err != nil {
+			return nil, fmt.Errorf("usage: scan summary: %w", err)
+		}
+
+		if keyUsage.LastSeen, err = parseSQLiteTime(lastSeen); err != nil {
+			return nil, fmt.Errorf("usage: parse last seen: %w", err)
+		}
+
+		if apiKey := u.keys.FindByHash(keyHash); apiKey != nil {
+			keyUsage.KeyID, keyUsage.Owner = apiKey.ID, apiKey.Owner
+		}
+
+		result = append(result, keyUsage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("usage: read summary: %w", err)
+	}
+
+	return result, nil
+}
+
+// parseSQLiteTime parses a date that the driver returns as text, e.g. a result of aggregate function.
+func parseSQLiteTime(value string) (time.Time, error) {
+	for _, format := range sqlite3.SQLiteTimestampFormats {
+		if result, err := time.Parse(format, value); err == nil {
+			return result, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unknown date format: %s", value)
+}
@@ -0,0 +1,103 @@
+package raid
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUsage(t *testing.T) {
+	chdirTemp(t)
+
+	ctx := context.Background()
+	keys := NewKeyStore("keys", []string{"static"})
+	usage := NewUsage("usage", keys)
+	keyHash := hashAPIKey("static")
+
+	usage.AddRequest(keyHash, "/api/states", false)
+	usage.AddRequest(keyHash, "/api/states", true)
+	usage.AddBytes(keyHash, "/api/states", 100)
+	usage.AddRequest(hashAPIKey("other"), "/api/states", false)
+
+	connection := usage.Connect(keyHash, "/api/states/live")
+	connection.accountedAt = connection.accountedAt.Add(-30 * time.Second)
+
+	if err := usage.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Counters are added to the saved ones, and open connections are accounted on every flush.
+	usage.AddRequest(keyHash, "/api/states", false)
+	usage.AddBytes(keyHash, "/api/states", 50)
+	connection.accountedAt = connection.accountedAt.Add(-10 * time.Second)
+	usage.Disconnect(connection)
+
+	if err := usage.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	records, err := usage.Query(ctx, keyHash, now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2: %+v", len(records), records)
+	}
+
+	if records[0].Endpoint != "/api/states" || records[0].Requests != 3 || records[0].Rejected != 1 ||
+		records[0].BytesSent != 150 || !records[0].Hour.Equal(now.UTC().Truncate(time.Hour)) {
+		t.Errorf("got record %+v", records[0])
+	}
+
+	if records[1].Endpoint != "/api/states/live" || math.Abs(records[1].ConnectionSeconds-40) > 1 {
+		t.Errorf("got record %+v", records[1])
+	}
+
+	if records, _ := usage.Query(ctx, keyHash, now.Add(time.Hour), now.Add(2*time.Hour)); len(records) != 0 {
+		t.Errorf("got records %+v out of period", records)
+	}
+
+	summary, err := usage.Summary(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summary) != 2 {
+		t.Fatalf("got %d keys in summary, want 2", len(summary))
+	}
+
+	if summary[0].Owner != "settings" || summary[0].Requests != 3 || summary[1].Owner != "" || summary[1].Requests != 1 {
+		t.Errorf("got summary %+v", summary)
+	}
+}
+
+func TestUsageMiddleware(t *testing.T) {
+	api := newTestAPI(t)
+
+	for _, path := range []string{"/api/states", "/api/states/12", "/api/states/14"} {
+		if status := api.get(t, path, &map[string]interface{}{}); status != 200 {
+			t.Fatalf("%s: got status %d", path, status)
+		}
+	}
+
+	if err := api.usage.flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	records, err := api.usage.Query(context.Background(), hashAPIKey(testAPIKey), now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Endpoints are identified by route templates.
+	if len(records) != 2 || records[0].Endpoint != "/api/states" || records[0].Requests != 1 ||
+		records[1].Endpoint != "/api/states/{id}" || records[1].Requests != 2 || records[1].BytesSent == 0 {
+		t.Errorf("got records %+v", records)
+	}
+}
@@ -0,0 +1,166 @@
+package raid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	webhookAttemptsDefaultLimit = 50
+	webhookAttemptsMaxLimit     = 500
+)
+
+// CreateWebhookRequest is a body of POST /api/webhooks.
+type CreateWebhookRequest struct {
+	URL     string `json:"url"`
+	Regions []int  `json:"regions"`
+}
+
+// registerWebhookRoutes adds endpoints to manage webhooks of the requesting API key.
+func (a *APIServer) registerWebhookRoutes(apiMux *mux.Router) {
+	apiMux.HandleFunc("/webhooks", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		webhooks, err := a.webhooks.List(r.Context(), requestAPIKey(r).Hash)
+		if err != nil {
+			log.Errorf("api: list webhooks: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+
+			return
+		}
+
+		rw.WriteHeader(200)
+		_ = enc.Encode(map[string][]Webhook{"webhooks": webhooks})
+	}).Methods("GET")
+
+	apiMux.HandleFunc("/webhooks", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		request := CreateWebhookRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": fmt.Sprintf("Invalid request body: %v", err)})
+
+			return
+		}
+
+		apiKey := requestAPIKey(r)
+
+		if err := a.validateWebhook(apiKey, request); err != nil {
+			rw.WriteHeader(400)
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+
+			return
+		}
+
+		webhook, err := a.webhooks.Create(r.Context(), apiKey.Hash, request.URL, request.Regions)
+
+		switch {
+		case errors.Is(err, ErrTooManyWebhooks):
+			rw.WriteHeader(409)
+			_ = enc.Encode(map[string]string{"error": fmt.Sprintf("At most %d webhooks are allowed per key", webhookMaxPerKey)})
+		case err != nil:
+			log.Errorf("api: create webhook: %v", err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while saving data to DB"})
+		default:
+			log.Infof("api: create webhook %d for key %d", webhook.ID, apiKey.ID)
+			rw.WriteHeader(201)
+			_ = enc.Encode(webhook)
+		}
+	}).Methods("POST")
+
+	apiMux.HandleFunc("/webhooks/{id:[0-9]+}", func(rw http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+
+		err := a.webhooks.Delete(r.Context(), requestAPIKey(r).Hash, id)
+
+		switch {
+		case errors.Is(err, ErrWebhookNotFound):
+			rw.Header().Add("Content-Type", "application/json")
+			rw.WriteHeader(404)
+			_ = json.NewEncoder(rw).Encode(map[string]string{"error": "Unknown webhook ID"})
+		case err != nil:
+			log.Errorf("api: delete webhook %d: %v", id, err)
+			rw.Header().Add("Content-Type", "application/json")
+			rw.WriteHeader(500)
+			_ = json.NewEncoder(rw).Encode(map[string]string{"error": "Internal server error while accessing DB"})
+		default:
+			log.Infof("api: delete webhook %d", id)
+			rw.WriteHeader(204)
+		}
+	}).Methods("DELETE")
+
+	apiMux.HandleFunc("/webhooks/{id:[0-9]+}/deliveries", func(rw http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+
+		rw.Header().Add("Content-Type", "application/json")
+		enc := json.NewEncoder(rw)
+
+		limit := webhookAttemptsDefaultLimit
+
+		if value := r.URL.Query().Get("limit"); value != "" {
+			var err error
+			if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > webhookAttemptsMaxLimit {
+				rw.WriteHeader(400)
+				_ = enc.Encode(map[string]string{
+					"error": fmt.Sprintf("Invalid limit, expected a number from 1 to %d", webhookAttemptsMaxLimit),
+				})
+
+				return
+			}
+		}
+
+		attempts, err := a.webhooks.Attempts(r.Context(), requestAPIKey(r).Hash, id, limit)
+
+		switch {
+		case errors.Is(err, ErrWebhookNotFound):
+			rw.WriteHeader(404)
+			_ = enc.Encode(map[string]string{"error": "Unknown webhook ID"})
+		case err != nil:
+			log.Errorf("api: list attempts of webhook %d: %v", id, err)
+			rw.WriteHeader(500)
+			_ = enc.Encode(map[string]string{"error": "Internal server error while fetching data from DB"})
+		default:
+			rw.WriteHeader(200)
+			_ = enc.Encode(map[string][]DeliveryAttempt{"attempts": attempts})
+		}
+	}).Methods("GET")
+}
+
+func (a *APIServer) validateWebhook(apiKey *APIKey, request CreateWebhookRequest) error {
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	// Host names are checked when webhooks are delivered, since they may resolve to other addresses later.
+	if ip := net.ParseIP(target.Hostname()); ip != nil && isInternalIP(ip) {
+		return errors.New("url must not point to a loopback, private or link-local address")
+	}
+
+	snapshot := a.updaterState.Snapshot()
+
+	for _, id := range request.Regions {
+		if snapshot.FindState(id) == nil {
+			return fmt.Errorf("unknown state ID in regions: %d", id)
+		}
+
+		if !apiKey.Allows(id) {
+			return fmt.Errorf("your API key has no access to state %d", id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,697 @@
+package raid
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	webhookWorkers = 8
+	// Deliveries are retried with exponential backoff: 10s, 20s, 40s, ... up to 1h between attempts.
+	webhookMaxAttempts  = 10
+	webhookBaseBackoff  = 10 * time.Second
+	webhookMaxBackoff   = time.Hour
+	webhookTimeout      = 10 * time.Second
+	webhookPollInterval = time.Second
+	// Dispatcher runs every poll interval and only sends to idle workers, so it's stuck if it didn't run for this long.
+	webhookMaxStall = time.Minute
+	// Deliveries of a webhook are sent in order, but a delivery that is still pending after this long
+	// stops holding back the later ones, since stale alerts are worse than reordered ones.
+	webhookMaxBlocking = time.Minute
+	// Finished deliveries are kept for this long, so that their attempts can be listed.
+	webhookRetention  = 7 * 24 * time.Hour
+	webhookMaxPerKey  = 10
+	webhookSecretSize = 32
+)
+
+const (
+	deliveryPending   = "pending"
+	deliveryDelivered = "delivered"
+	deliveryFailed    = "failed"
+)
+
+var (
+	ErrWebhookNotFound  = errors.New("webhook not found")
+	ErrTooManyWebhooks  = fmt.Errorf("at most %d webhooks are allowed per key", webhookMaxPerKey)
+	errUnexpectedStatus = errors.New("unexpected status")
+	errForbiddenAddress = errors.New("forbidden address")
+)
+
+// Webhook is a subscription of an API key owner to updates, which are POSTed to URL.
+type Webhook struct {
+	ID  int    `json:"id"`
+	URL string `json:"url"`
+	// IDs of states to send updates of, empty if all.
+	Regions []int `json:"regions"`
+	// Secret is used to sign payloads. It's only returned when the webhook is created.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (w *Webhook) matches(u Update) bool {
+	if len(w.Regions) == 0 {
+		return true
+	}
+
+	for _, id := range w.Regions {
+		if id == u.State.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeliveryAttempt is an attempt to deliver an update to a webhook.
+type DeliveryAttempt struct {
+	ID          int       `json:"id"`
+	DeliveryID  int       `json:"delivery_id"`
+	EventID     int64     `json:"event_id"`
+	AttemptedAt time.Time `json:"attempted_at"`
+	// Status code is 0 if no response was received.
+	StatusCode      int     `json:"status_code"`
+	Error           string  `json:"error"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	// Current status of the delivery: pending, delivered or failed.
+	DeliveryStatus string `json:"delivery_status"`
+}
+
+type delivery struct {
+	id        int
+	webhookID int
+	eventID   int64
+	payload   []byte
+	attempts  int
+	url       string
+	secret    string
+}
+
+// Webhooks queues fresh updates for every matching webhook in SQLite and delivers them with retries,
+// so that deliveries survive restarts.
+type Webhooks struct {
+	db             *sql.DB
+	keys           *KeyStore
+	updaterState   *UpdaterState
+	updates        *Topic[Update]
+	staleThreshold time.Duration
+	client         *http.Client
+	// IDs of deliveries which are being sent.
+	inFlight map[int]bool
+	mutex    sync.Mutex
+	// Dispatcher loop marks every iteration.
+	dispatched activity
+}
+
+func NewWebhooks(
+	dbname string, keys *KeyStore, updaterState *UpdaterState, updates *Topic[Update], staleThreshold time.Duration,
+) *Webhooks {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("./data/%s.sqlite?_busy_timeout=5000&_foreign_keys=1", dbname))
+	if err != nil {
+		log.Fatalf("webhooks: open DB: %s", err)
+	}
+
+	if err := migrate(context.Background(), db, "webhooks"); err != nil {
+		log.Fatalf("webhooks: %s", err)
+	}
+
+	return &Webhooks{
+		db:             db,
+		keys:           keys,
+		updaterState:   updaterState,
+		updates:        updates,
+		staleThreshold: staleThreshold,
+		client:         newWebhookClient(),
+		inFlight:       map[int]bool{},
+	}
+}
+
+// newWebhookClient returns HTTP client which can't reach internal network, since URLs are set by key owners.
+// Addresses are checked after DNS resolution, and redirects are not followed, so that they can't bypass the check.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("webhooks: parse address: %w", err)
+			}
+
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return fmt.Errorf("webhooks: %w: %s", errForbiddenAddress, host)
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+			MaxIdleConnsPerHost: webhookWorkers,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// Ping fails if DB is not available or dispatcher is stuck, e.g. if all workers hang.
+func (w *Webhooks) Ping(ctx context.Context) error {
+	if err := w.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("webhooks: ping DB: %w", err)
+	}
+
+	if err := w.dispatched.check("dispatch", webhookMaxStall); err != nil {
+		return fmt.Errorf("webhooks: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Webhooks) Run(ctx context.Context, wg *sync.WaitGroup, errch chan error) {
+	defer log.Debug("webhooks: exit")
+
+	defer wg.Done()
+	wg.Add(1)
+
+	events := w.updates.Subscribe("webhooks", OverflowBlock, func(u Update) bool {
+		return u.IsFresh
+	})
+	defer w.updates.Unsubscribe(events)
+
+	// Channel is buffered for all workers, so that dispatching never blocks receiving updates.
+	deliveries := make(chan delivery, webhookWorkers)
+	workers := &sync.WaitGroup{}
+
+	for i := 0; i < webhookWorkers; i++ {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for d := range deliveries {
+				w.deliver(ctx, d)
+			}
+		}()
+	}
+
+	defer workers.Wait()
+	defer close(deliveries)
+
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	cleanupTicker := time.NewTicker(time.Hour)
+	defer cleanupTicker.Stop()
+
+	w.dispatched.mark()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if err := w.enqueue(ctx, event); err != nil {
+				log.Error(err)
+			}
+		case <-ticker.C:
+		case <-cleanupTicker.C:
+			if err := w.cleanup(ctx); err != nil {
+				log.Error(err)
+			}
+
+			continue
+		case <-ctx.Done():
+			return
+		}
+
+		if err := w.dispatch(ctx, deliveries); err != nil {
+			log.Error(err)
+		}
+
+		w.dispatched.mark()
+	}
+}
+
+// enqueue adds deliveries of the update for all matching webhooks whose keys are still allowed to receive it.
+func (w *Webhooks) enqueue(ctx context.Context, update Update) error {
+	response := NewPollResponse(update)
+	response.Degraded = w.updaterState.Snapshot().Stale(w.staleThreshold)
+
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("webhooks: encode payload: %w", err)
+	}
+
+	webhooks, err := w.list(ctx, "", nil)
+	if err != nil {
+		return err
+	}
+
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("webhooks: begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now()
+	count := 0
+
+	for keyHash, keyWebhooks := range webhooks {
+		apiKey := w.keys.FindByHash(keyHash)
+		if apiKey == nil || !apiKey.IsActive(now) || !apiKey.Allows(update.State.ID) {
+			continue
+		}
+
+		for _, webhook := range keyWebhooks {
+			if !webhook.matches(update) {
+				continue
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO deliveries (webhook_id, event_id, payload, status, next_attempt_at, created_at)
+				VALUES (?, ?, ?, ?, ?, ?)
+			`, webhook.ID, update.EventID, string(payload), deliveryPending, now, now); err != nil {
+				return fmt.Errorf("webhooks: insert delivery: %w", err)
+			}
+
+			count++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("webhooks: commit deliveries: %w", err)
+	}
+
+	if count > 0 {
+		log.Debugf("webhooks: queue %d deliveries of event %d", count, update.EventID)
+	}
+
+	return nil
+}
+
+// dispatch sends due deliveries to idle workers.
+func (w *Webhooks) dispatch(ctx context.Context, deliveries chan delivery) error {
+	w.mutex.Lock()
+	idle := webhookWorkers - len(w.inFlight)
+	inFlight := make([]string, 0, len(w.inFlight))
+
+	for id := range w.inFlight {
+		inFlight = append(inFlight, strconv.Itoa(id))
+	}
+	w.mutex.Unlock()
+
+	if idle <= 0 {
+		return nil
+	}
+
+	// Only the oldest pending delivery of each webhook is sent, so that receivers get updates in order
+	// even if some of them are retried, unless it's pending for longer than webhookMaxBlocking.
+	// IDs are formatted into the query since there are at most webhookWorkers of them.
+	now := time.Now()
+	blockingSince := now.Add(-webhookMaxBlocking)
+	rows, err := w.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT deliveries.id, webhook_id, event_id, payload, attempts, url, secret
+		FROM deliveries
+		JOIN webhooks ON webhooks.id = deliveries.webhook_id
+		WHERE status = ? AND julianday(next_attempt_at) <= julianday(?) AND deliveries.id NOT IN (%s)
+			AND (julianday(deliveries.created_at) <= julianday(?) OR deliveries.id IN (
+				SELECT MIN(id) FROM deliveries WHERE status = ? AND julianday(created_at) > julianday(?) GROUP BY webhook_id
+			))
+		ORDER BY deliveries.id
+		LIMIT ?
+	`, strings.Join(inFlight, ",")), deliveryPending, now, blockingSince, deliveryPending, blockingSince, idle)
+	if err != nil {
+		return fmt.Errorf("webhooks: query due deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	due := []delivery{}
+
+	for rows.Next() {
+		d := delivery{}
+		if err := rows.Scan(&d.id, &d.webhookID, &d.eventID, &d.payload, &d.attempts, &d.url, &d.secret); err != nil {
+			return fmt.Errorf("webhooks: scan delivery: %w", err)
+		}
+
+		due = append(due, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("webhooks: read due deliveries: %w", err)
+	}
+
+	w.mutex.Lock()
+	for _, d := range due {
+		w.inFlight[d.id] = true
+	}
+	w.mutex.Unlock()
+
+	for _, d := range due {
+		deliveries <- d
+	}
+
+	return nil
+}
+
+// Sign returns signature of a payload sent at timestamp, which receivers should compare with X-Raid-Signature header.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *Webhooks) deliver(ctx context.Context, d delivery) {
+	defer func() {
+		w.mutex.Lock()
+		delete(w.inFlight, d.id)
+		w.mutex.Unlock()
+	}()
+
+	start := time.Now()
+	statusCode, err := w.post(ctx, d)
+	duration := time.Since(start)
+
+	// Interrupted deliveries are retried after restart without counting the attempt.
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err := w.recordAttempt(ctx, d, start, duration, statusCode, err); err != nil {
+		log.Error(err)
+	}
+}
+
+func (w *Webhooks) post(ctx context.Context, d delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", d.url, strings.NewReader(string(d.payload)))
+	if err != nil {
+		return 0, fmt.Errorf("webhooks: create request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "raid-webhooks")
+	req.Header.Set("X-Raid-Event-ID", strconv.FormatInt(d.eventID, 10))
+	req.Header.Set("X-Raid-Delivery-ID", strconv.Itoa(d.id))
+	req.Header.Set("X-Raid-Timestamp", timestamp)
+	req.Header.Set("X-Raid-Signature", Sign(d.secret, timestamp, d.payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhooks: send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Response is not used, but reading it allows reusing the connection.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+
+	return backoff
+}
+
+func (w *Webhooks) recordAttempt(
+	ctx context.Context, d delivery, start time.Time, duration time.Duration, statusCode int, deliveryErr error,
+) error {
+	attempts := d.attempts + 1
+	status, nextAttemptAt, errorText := deliveryDelivered, start, ""
+
+	switch {
+	case deliveryErr == nil:
+		webhookDeliveries.WithLabelValues("delivered").Inc()
+	case attempts >= webhookMaxAttempts:
+		status, errorText = deliveryFailed, deliveryErr.Error()
+		webhookDeliveries.WithLabelValues("failed").Inc()
+		log.Warnf("webhooks: give up delivery %d to webhook %d: %v", d.id, d.webhookID, deliveryErr)
+	default:
+		status, errorText = deliveryPending, deliveryErr.Error()
+		nextAttemptAt = time.Now().Add(webhookBackoff(attempts))
+		webhookDeliveries.WithLabelValues("retry").Inc()
+		log.Debugf("webhooks: retry delivery %d to webhook %d at %s: %v", d.id, d.webhookID, nextAttemptAt, deliveryErr)
+	}
+
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("webhooks: begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE deliveries SET status = ?, attempts = ?, next_attempt_at = ? WHERE id = ?
+	`, status, attempts, nextAttemptAt, d.id)
+	if err != nil {
+		return fmt.Errorf("webhooks: update delivery %d: %w", d.id, err)
+	}
+
+	// The webhook was deleted during the attempt.
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO delivery_attempts (delivery_id, webhook_id, attempted_at, status_code, error, duration)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, d.id, d.webhookID, start, statusCode, errorText, duration.Seconds()); err != nil {
+		return fmt.Errorf("webhooks: insert attempt of delivery %d: %w", d.id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("webhooks: commit attempt of delivery %d: %w", d.id, err)
+	}
+
+	return nil
+}
+
+// cleanup removes old finished deliveries along with their attempts.
+func (w *Webhooks) cleanup(ctx context.Context) error {
+	result, err := w.db.ExecContext(ctx, `
+		DELETE FROM deliveries WHERE status != ? AND julianday(created_at) < julianday(?)
+	`, deliveryPending, time.Now().Add(-webhookRetention))
+	if err != nil {
+		return fmt.Errorf("webhooks: clean up deliveries: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected > 0 {
+		log.Infof("webhooks: remove %d old deliveries", affected)
+	}
+
+	return nil
+}
+
+// list returns webhooks grouped by hashes of their keys, optionally filtered by key hash and ID.
+func (w *Webhooks) list(ctx context.Context, keyHash string, id *int) (map[string][]Webhook, error) {
+	conditions := []string{"1"}
+	args := []interface{}{}
+
+	if keyHash != "" {
+		conditions = append(conditions, "key_hash = ?")
+		args = append(args, keyHash)
+	}
+
+	if id != nil {
+		conditions = append(conditions, "id = ?")
+		args = append(args, *id)
+	}
+
+	rows, err := w.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, key_hash, url, regions, created_at
+		FROM webhooks
+		WHERE %s
+		ORDER BY id
+	`, strings.Join(conditions, " AND ")), args...)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	result := map[string][]Webhook{}
+
+	for rows.Next() {
+		var (
+			webhook   Webhook
+			keyHash   string
+			regions   string
+			regionIDs []int
+		)
+
+		if err := rows.Scan(&webhook.ID, &keyHash, &webhook.URL, &regions, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("webhooks: scan webhook: %w", err)
+		}
+
+		if regionIDs, err = parseRegions(regions); err != nil {
+			return nil, fmt.Errorf("webhooks: invalid regions of webhook %d: %w", webhook.ID, err)
+		}
+
+		webhook.Regions = regionIDs
+		result[keyHash] = append(result[keyHash], webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("webhooks: read webhooks: %w", err)
+	}
+
+	return result, nil
+}
+
+// List returns webhooks of a key.
+func (w *Webhooks) List(ctx context.Context, keyHash string) ([]Webhook, error) {
+	webhooks, err := w.list(ctx, keyHash, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhooks[keyHash] == nil {
+		return []Webhook{}, nil
+	}
+
+	return webhooks[keyHash], nil
+}
+
+// Create adds a webhook of a key with a generated secret.
+func (w *Webhooks) Create(ctx context.Context, keyHash string, url string, regions []int) (Webhook, error) {
+	var count int
+	if err := w.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM webhooks WHERE key_hash = ?", keyHash).Scan(&count); err != nil {
+		return Webhook{}, fmt.Errorf("webhooks: count webhooks: %w", err)
+	}
+
+	if count >= webhookMaxPerKey {
+		return Webhook{}, ErrTooManyWebhooks
+	}
+
+	buf := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return Webhook{}, fmt.Errorf("webhooks: generate secret: %w", err)
+	}
+
+	webhook := Webhook{
+		URL:       url,
+		Regions:   append([]int{}, regions...),
+		Secret:    hex.EncodeToString(buf),
+		CreatedAt: time.Now(),
+	}
+
+	result, err := w.db.ExecContext(ctx, `
+		INSERT INTO webhooks (key_hash, url, regions, secret, created_at) VALUES (?, ?, ?, ?, ?)
+	`, keyHash, webhook.URL, formatRegions(webhook.Regions), webhook.Secret, webhook.CreatedAt)
+	if err != nil {
+		return Webhook{}, fmt.Errorf("webhooks: insert webhook: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Webhook{}, fmt.Errorf("webhooks: get webhook ID: %w", err)
+	}
+
+	webhook.ID = int(id)
+
+	return webhook, nil
+}
+
+// Delete removes a webhook of a key along with its pending deliveries.
+func (w *Webhooks) Delete(ctx context.Context, keyHash string, id int) error {
+	result, err := w.db.ExecContext(ctx, "DELETE FROM webhooks WHERE id = ? AND key_hash = ?", id, keyHash)
+	if err != nil {
+		return fmt.Errorf("webhooks: delete webhook %d: %w", id, err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return fmt.Errorf("webhooks: delete webhook %d: %w", id, ErrWebhookNotFound)
+	}
+
+	return nil
+}
+
+// Attempts returns recent delivery attempts of a webhook of a key, newest first.
+func (w *Webhooks) Attempts(ctx context.Context, keyHash string, id int, limit int) ([]DeliveryAttempt, error) {
+	webhooks, err := w.list(ctx, keyHash, &id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks[keyHash]) == 0 {
+		return nil, fmt.Errorf("webhooks: list attempts of webhook %d: %w", id, ErrWebhookNotFound)
+	}
+
+	rows, err := w.db.QueryContext(ctx, `
+		SELECT
+			delivery_attempts.id, delivery_id, event_id, attempted_at, status_code, error, duration, status
+		FROM delivery_attempts
+		JOIN deliveries ON deliveries.id = delivery_attempts.delivery_id
+		WHERE delivery_attempts.webhook_id = ?
+		ORDER BY delivery_attempts.id DESC
+		LIMIT ?
+	`, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: query attempts: %w", err)
+	}
+	defer rows.Close()
+
+	result := []DeliveryAttempt{}
+
+	for rows.Next() {
+		attempt := DeliveryAttempt{}
+		if err := rows.Scan(
+			&attempt.ID, &attempt.DeliveryID, &attempt.EventID, &attempt.AttemptedAt, &attempt.StatusCode, &attempt.Error,
+			&attempt.DurationSeconds, &attempt.DeliveryStatus,
+		); err != nil {
+			return nil, fmt.Errorf("webhooks: scan attempt: %w", err)
+		}
+
+		result = append(result, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("webhooks: read attempts: %w", err)
+	}
+
+	return result, nil
+}
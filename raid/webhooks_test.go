@@ -0,0 +1,169 @@
+package raid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	want := "sha256=59f62fbc2cdbfdea239994f03a890e5d56873a6b6893ac054b97575b86557b73"
+	if got := Sign("secret", "1651723815", []byte(`{"event_id":1}`)); got != want {
+		t.Errorf("got signature %s, want %s", got, want)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	want := []time.Duration{
+		10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second, 160 * time.Second,
+		320 * time.Second, 640 * time.Second, 1280 * time.Second, 2560 * time.Second, time.Hour,
+	}
+
+	for i, backoff := range want {
+		if got := webhookBackoff(i + 1); got != backoff {
+			t.Errorf("attempt %d: got backoff %s, want %s", i+1, got, backoff)
+		}
+	}
+}
+
+func TestWebhookClientForbidsInternalAddresses(t *testing.T) {
+	for address, internal := range map[string]bool{
+		"127.0.0.1":       true,
+		"10.1.2.3":        true,
+		"192.168.0.1":     true,
+		"169.254.169.254": true,
+		"0.0.0.0":         true,
+		"::1":             true,
+		"fd00::1":         true,
+		"224.0.0.1":       true,
+		"8.8.8.8":         false,
+		"2001:4860::8888": false,
+	} {
+		if got := isInternalIP(net.ParseIP(address)); got != internal {
+			t.Errorf("%s: got internal %v, want %v", address, got, internal)
+		}
+	}
+
+	// Addresses are checked after resolving, so that DNS names can't point to internal network either.
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		t.Error("request reached internal server")
+	}))
+	defer server.Close()
+
+	client := newWebhookClient()
+
+	for _, url := range []string{server.URL, "http://localhost:" + server.URL[len("http://127.0.0.1:"):]} {
+		if _, err := client.Post(url, "application/json", nil); !errors.Is(err, errForbiddenAddress) {
+			t.Errorf("%s: got error %v, want %v", url, err, errForbiddenAddress)
+		}
+	}
+
+	if err := client.CheckRedirect(nil, nil); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Errorf("got redirect error %v, want %v", err, http.ErrUseLastResponse)
+	}
+}
+
+func TestWebhooksDispatch(t *testing.T) {
+	chdirTemp(t)
+
+	ctx := context.Background()
+	webhooks := NewWebhooks("webhooks", NewKeyStore("keys", nil), NewUpdaterState(), NewTopic[Update](), time.Minute)
+	now := time.Now()
+
+	webhookIDs := []int{}
+
+	for i := 0; i < 3; i++ {
+		webhook, err := webhooks.Create(ctx, "hash", fmt.Sprintf("https://example.com/%d", i), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		webhookIDs = append(webhookIDs, webhook.ID)
+	}
+
+	addDelivery := func(webhookID int, age time.Duration, nextAttemptIn time.Duration) {
+		t.Helper()
+
+		if _, err := webhooks.db.Exec(`
+			INSERT INTO deliveries (webhook_id, event_id, payload, status, next_attempt_at, created_at)
+			VALUES (?, 1, '{}', ?, ?, ?)
+		`, webhookID, deliveryPending, now.Add(nextAttemptIn), now.Add(-age)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Webhook 1: the oldest delivery is being retried and holds back the later ones.
+	addDelivery(webhookIDs[0], 30*time.Second, time.Minute) // 1
+	addDelivery(webhookIDs[0], 20*time.Second, 0)           // 2
+	addDelivery(webhookIDs[0], 0, 0)                        // 3
+	// Webhook 2: the oldest delivery is retried for too long, so the next one is sent.
+	addDelivery(webhookIDs[1], 2*time.Minute, time.Minute) // 4
+	addDelivery(webhookIDs[1], 0, 0)                       // 5
+	addDelivery(webhookIDs[1], 0, 0)                       // 6
+	// Webhook 3: old deliveries are retried along with the oldest recent one.
+	addDelivery(webhookIDs[2], 3*time.Minute, 0) // 7
+	addDelivery(webhookIDs[2], 2*time.Minute, 0) // 8
+	addDelivery(webhookIDs[2], 0, 0)             // 9
+	addDelivery(webhookIDs[2], 0, 0)             // 10
+
+	dispatched := func() []int {
+		t.Helper()
+
+		deliveries := make(chan delivery, webhookWorkers)
+		if err := webhooks.dispatch(ctx, deliveries); err != nil {
+			t.Fatal(err)
+		}
+
+		close(deliveries)
+
+		ids := []int{}
+		for d := range deliveries {
+			ids = append(ids, d.id)
+		}
+
+		sort.Ints(ids)
+
+		return ids
+	}
+
+	if ids := dispatched(); fmt.Sprint(ids) != "[5 7 8 9]" {
+		t.Errorf("got dispatched deliveries %v, want [5 7 8 9]", ids)
+	}
+
+	// Deliveries in flight are not sent again, and the next ones wait for them.
+	if ids := dispatched(); len(ids) != 0 {
+		t.Errorf("got dispatched deliveries %v while previous ones are in flight", ids)
+	}
+
+	finishTestDelivery(t, webhooks, 5, nil)
+	finishTestDelivery(t, webhooks, 9, errUnexpectedStatus)
+
+	// Delivery 9 is retried later, and delivery 10 waits for it.
+	if ids := dispatched(); fmt.Sprint(ids) != "[6]" {
+		t.Errorf("got dispatched deliveries %v, want [6]", ids)
+	}
+}
+
+// finishTestDelivery finishes an attempt of a dispatched delivery like deliver does.
+func finishTestDelivery(t *testing.T, w *Webhooks, id int, deliveryErr error) {
+	t.Helper()
+
+	d := delivery{id: id}
+	if err := w.db.QueryRow("SELECT webhook_id, attempts FROM deliveries WHERE id = ?", id).Scan(&d.webhookID, &d.attempts); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.recordAttempt(context.Background(), d, time.Now(), time.Millisecond, 200, deliveryErr); err != nil {
+		t.Fatal(err)
+	}
+
+	w.mutex.Lock()
+	delete(w.inFlight, id)
+	w.mutex.Unlock()
+}
@@ -0,0 +1,443 @@
+package raid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	wsSubprotocol    = "raid"
+	wsKeyPrefix      = "key."
+	wsAuthTimeout    = 5 * time.Second
+	wsPingInterval   = 15 * time.Second
+	wsWriteTimeout   = 5 * time.Second
+	wsMaxMessageSize = 4096
+	wsEndpoint       = "/api/ws"
+)
+
+var (
+	errWSUnauthorized    = errors.New("unknown or missing API key")
+	errWSMissingProtocol = errors.New(`"raid" subprotocol must be offered along with the key`)
+)
+
+// WSCommand is a message sent by WebSocket client.
+type WSCommand struct {
+	Action string `json:"action"`
+	Key    string `json:"key,omitempty"`
+	IDs    []int  `json:"ids,omitempty"`
+}
+
+// WSMessage is a message sent to WebSocket client.
+type WSMessage struct {
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
+
+type WSSubscribedResponse struct {
+	All bool  `json:"all"`
+	IDs []int `json:"ids"`
+}
+
+type WSRejectedResponse struct {
+	Error string `json:"error"`
+	IDs   []int  `json:"ids"`
+}
+
+// wsSubscription holds region IDs that a WebSocket client is interested in.
+// It is read by topic filter and modified by client commands concurrently.
+type wsSubscription struct {
+	all     bool
+	regions map[int]bool
+	// Subscribing to a district also delivers state-level updates of its parent state.
+	parents map[int]int
+	mutex   sync.Mutex
+}
+
+func newWSSubscription() *wsSubscription {
+	return &wsSubscription{
+		all:     true,
+		regions: map[int]bool{},
+		parents: map[int]int{},
+	}
+}
+
+func (s *wsSubscription) matches(u Update) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.all {
+		return true
+	}
+
+	if u.District != nil {
+		return s.regions[u.District.ID] || s.regions[u.State.ID]
+	}
+
+	if s.regions[u.State.ID] {
+		return true
+	}
+
+	for _, parent := range s.parents {
+		if parent == u.State.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subscribe adds regions to subscription, or subscribes to all of them if ids are empty.
+// If some IDs are unknown or not allowed by the key, subscription is left unchanged and these IDs are returned.
+func (s *wsSubscription) subscribe(snapshot *UpdaterSnapshot, apiKey *APIKey, ids []int) []int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(ids) == 0 {
+		s.all = true
+		s.regions = map[int]bool{}
+		s.parents = map[int]int{}
+
+		return nil
+	}
+
+	rejected := []int{}
+	parents := map[int]int{}
+
+	for _, id := range ids {
+		if state := snapshot.FindState(id); state != nil {
+			if !apiKey.Allows(state.ID) {
+				rejected = append(rejected, id)
+			}
+
+			continue
+		}
+
+		state, district := snapshot.FindDistrict(id)
+		if district == nil || !apiKey.Allows(state.ID) {
+			rejected = append(rejected, id)
+
+			continue
+		}
+
+		parents[id] = state.ID
+	}
+
+	if len(rejected) > 0 {
+		return rejected
+	}
+
+	s.all = false
+
+	for _, id := range ids {
+		s.regions[id] = true
+	}
+
+	for id, parent := range parents {
+		s.parents[id] = parent
+	}
+
+	return nil
+}
+
+// unsubscribe removes regions from subscription, or all of them if ids are empty.
+// Unsubscribing from a region while subscribed to all states subscribes to the rest of them.
+func (s *wsSubscription) unsubscribe(snapshot *UpdaterSnapshot, ids []int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(ids) == 0 {
+		s.all = false
+		s.regions = map[int]bool{}
+		s.parents = map[int]int{}
+
+		return
+	}
+
+	if s.all {
+		s.all = false
+
+		for _, state := range snapshot.States {
+			s.regions[state.ID] = true
+		}
+	}
+
+	for _, id := range ids {
+		delete(s.regions, id)
+		delete(s.parents, id)
+	}
+}
+
+func (s *wsSubscription) describe() WSSubscribedResponse {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ids := []int{}
+	for id := range s.regions {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	return WSSubscribedResponse{s.all, ids}
+}
+
+// Subprotocol is chosen by wsResponseProtocol.
+var wsUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// wsResponseProtocol returns subprotocol selected by server, which browsers require if client offers any.
+// Only "raid" is ever selected, so that the key is not sent back. Offering the key alone is an error.
+func wsResponseProtocol(r *http.Request) (string, error) {
+	protocols := websocket.Subprotocols(r)
+
+	for _, protocol := range protocols {
+		if protocol == wsSubprotocol {
+			return protocol, nil
+		}
+	}
+
+	for _, protocol := range protocols {
+		if strings.HasPrefix(protocol, wsKeyPrefix) {
+			return "", errWSMissingProtocol
+		}
+	}
+
+	return "", nil
+}
+
+// authenticateWebSocket checks API key sent either as "key.<API key>" subprotocol
+// or as the first message: {"action": "auth", "key": "<API key>"}, and returns the key.
+func (a *APIServer) authenticateWebSocket(conn *websocket.Conn, r *http.Request) (string, *APIKey, error) {
+	for _, protocol := range websocket.Subprotocols(r) {
+		if strings.HasPrefix(protocol, wsKeyPrefix) {
+			key := strings.TrimPrefix(protocol, wsKeyPrefix)
+			if apiKey := a.keys.Lookup(key); apiKey != nil {
+				return key, apiKey, nil
+			}
+
+			return "", nil, errWSUnauthorized
+		}
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(wsAuthTimeout)); err != nil {
+		return "", nil, err
+	}
+
+	command := WSCommand{}
+	if err := conn.ReadJSON(&command); err != nil {
+		return "", nil, err
+	}
+
+	if command.Action != "auth" {
+		return "", nil, errWSUnauthorized
+	}
+
+	apiKey := a.keys.Lookup(command.Key)
+	if apiKey == nil {
+		return "", nil, errWSUnauthorized
+	}
+
+	return command.Key, apiKey, nil
+}
+
+func (a *APIServer) handleWebSocket(ctx context.Context) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		protocol, err := wsResponseProtocol(r)
+		if err != nil {
+			rw.Header().Add("Content-Type", "application/json")
+			rw.WriteHeader(400)
+			_ = json.NewEncoder(rw).Encode(map[string]string{"error": `Subprotocol "raid" must be offered along with the key`})
+
+			return
+		}
+
+		var header http.Header
+		if protocol != "" {
+			header = http.Header{"Sec-Websocket-Protocol": {protocol}}
+		}
+
+		conn, err := wsUpgrader.Upgrade(rw, r, header)
+		if err != nil {
+			log.Errorf("api: upgrade to websocket: %v", err)
+
+			return
+		}
+		defer conn.Close()
+
+		conn.SetReadLimit(wsMaxMessageSize)
+
+		// Bytes are only accounted after authentication.
+		var apiKey *APIKey
+
+		write := func(event string, data interface{}) error {
+			if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+				return err
+			}
+
+			message, err := json.Marshal(WSMessage{event, data})
+			if err != nil {
+				return err
+			}
+
+			if apiKey != nil {
+				a.usage.AddBytes(apiKey.Hash, wsEndpoint, len(message))
+			}
+
+			return conn.WriteMessage(websocket.TextMessage, message)
+		}
+
+		key, apiKey, err := a.authenticateWebSocket(conn, r)
+		if err != nil {
+			log.Debugf("api: websocket auth failed: %v", err)
+
+			_ = write("error", map[string]string{"error": "Unknown or missing API key"})
+
+			return
+		}
+
+		a.usage.AddRequest(apiKey.Hash, wsEndpoint, false)
+
+		connection := a.usage.Connect(apiKey.Hash, wsEndpoint)
+		defer a.usage.Disconnect(connection)
+
+		log.Info("api: websocket subscribe to events")
+
+		subscription := newWSSubscription()
+		events := a.updates.Subscribe("ws-"+r.RemoteAddr, OverflowDisconnect, func(u Update) bool {
+			return u.IsFresh && apiKey.Allows(u.State.ID) && subscription.matches(u)
+		})
+
+		defer func() {
+			log.Info("api: websocket unsubscribe from events")
+			a.updates.Unsubscribe(events)
+		}()
+
+		extendDeadline := func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * wsPingInterval))
+		}
+		conn.SetPongHandler(extendDeadline)
+		_ = extendDeadline("")
+
+		done := make(chan struct{})
+		defer close(done)
+
+		commands := make(chan WSCommand)
+		readErrors := make(chan error, 1)
+
+		go func() {
+			for {
+				command := WSCommand{}
+				if err := conn.ReadJSON(&command); err != nil {
+					readErrors <- err
+
+					return
+				}
+
+				_ = extendDeadline("")
+
+				select {
+				case commands <- command:
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		if err := write("hello", nil); err != nil {
+			log.Errorf("api: send websocket hello: %v", err)
+
+			return
+		}
+
+		ticker := time.NewTicker(wsPingInterval)
+		defer ticker.Stop()
+
+		keysChanged := a.keys.Changed()
+
+		for {
+			var err error
+
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				err = write("update", a.newPollResponse(event))
+			case command := <-commands:
+				err = a.handleWebSocketCommand(command, apiKey, subscription, write)
+			case err = <-readErrors:
+				log.Debugf("api: websocket read: %v", err)
+
+				return
+			case <-ticker.C:
+				if !a.keys.IsCurrent(key, apiKey) {
+					closeWebSocket(conn, websocket.ClosePolicyViolation, "API key was changed")
+
+					return
+				}
+
+				err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+			case <-keysChanged:
+				if !a.keys.IsCurrent(key, apiKey) {
+					closeWebSocket(conn, websocket.ClosePolicyViolation, "API key was changed")
+
+					return
+				}
+
+				keysChanged = a.keys.Changed()
+			case <-ctx.Done():
+				closeWebSocket(conn, websocket.CloseGoingAway, "")
+
+				return
+			}
+
+			if err != nil {
+				log.Errorf("api: websocket write: %v", err)
+
+				return
+			}
+		}
+	}
+}
+
+func closeWebSocket(conn *websocket.Conn, code int, text string) {
+	_ = conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, text),
+		time.Now().Add(wsWriteTimeout),
+	)
+}
+
+func (a *APIServer) handleWebSocketCommand(
+	command WSCommand, apiKey *APIKey, subscription *wsSubscription, write func(string, interface{}) error,
+) error {
+	switch command.Action {
+	case "subscribe":
+		if rejected := subscription.subscribe(a.updaterState.Snapshot(), apiKey, command.IDs); len(rejected) > 0 {
+			return write("error", WSRejectedResponse{"Unknown or forbidden region IDs", rejected})
+		}
+
+		return write("subscribed", subscription.describe())
+	case "unsubscribe":
+		subscription.unsubscribe(a.updaterState.Snapshot(), command.IDs)
+
+		return write("subscribed", subscription.describe())
+	case "ping":
+		return write("pong", StatusResponse{a.isDegraded(a.updaterState.Snapshot())})
+	default:
+		return write("error", map[string]string{"error": "Unknown action"})
+	}
+}
@@ -0,0 +1,191 @@
+package raid
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type wsTestMessage struct {
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
+
+// dialWebSocket connects to the test API with given subprotocols.
+func (a *testAPI) dialWebSocket(t *testing.T, protocols ...string) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+
+	dialer := websocket.Dialer{Subprotocols: protocols, HandshakeTimeout: time.Second}
+
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(a.URL, "http")+wsEndpoint, nil)
+	if err == nil {
+		t.Cleanup(func() { conn.Close() })
+	}
+
+	return conn, resp, err
+}
+
+func readWSMessage(t *testing.T, conn *websocket.Conn) wsTestMessage {
+	t.Helper()
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+
+	message := wsTestMessage{}
+	if err := conn.ReadJSON(&message); err != nil {
+		t.Fatal(err)
+	}
+
+	return message
+}
+
+// sendWSCommand sends a command and returns the reply, which is re-encoded to compare with expected one.
+func sendWSCommand(t *testing.T, conn *websocket.Conn, command WSCommand) wsTestMessage {
+	t.Helper()
+
+	if err := conn.WriteJSON(command); err != nil {
+		t.Fatal(err)
+	}
+
+	return readWSMessage(t, conn)
+}
+
+func TestWebSocketProtocol(t *testing.T) {
+	api := newTestAPI(t)
+
+	// The key is never sent back, so browsers need "raid" to be offered along with it.
+	_, resp, err := api.dialWebSocket(t, wsKeyPrefix+testAPIKey)
+	if err == nil || resp == nil || resp.StatusCode != 400 {
+		t.Fatalf("dial with key only: err = %v, want status 400", err)
+	}
+
+	conn, resp, err := api.dialWebSocket(t, wsKeyPrefix+testAPIKey, wsSubprotocol)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if protocol := resp.Header.Get("Sec-Websocket-Protocol"); protocol != wsSubprotocol {
+		t.Errorf("selected protocol = %q, want %q", protocol, wsSubprotocol)
+	}
+
+	if message := readWSMessage(t, conn); message.Event != "hello" {
+		t.Errorf("first message = %+v, want hello", message)
+	}
+
+	conn, _, err = api.dialWebSocket(t, wsSubprotocol, wsKeyPrefix+"unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message := readWSMessage(t, conn); message.Event != "error" {
+		t.Errorf("message with unknown key = %+v, want error", message)
+	}
+
+	// Key may also be sent as the first message.
+	conn, _, err = api.dialWebSocket(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message := sendWSCommand(t, conn, WSCommand{Action: "auth", Key: testAPIKey}); message.Event != "hello" {
+		t.Errorf("reply to auth = %+v, want hello", message)
+	}
+}
+
+func TestWebSocketSubscriptions(t *testing.T) {
+	api := newTestAPI(t)
+	snapshot := api.updaterState.Snapshot()
+
+	var district *District
+
+	for _, state := range snapshot.States {
+		if state.ID == 14 && len(state.Districts) > 0 {
+			district = &state.Districts[0]
+		}
+	}
+
+	if district == nil {
+		t.Fatal("state 14 has no districts")
+	}
+
+	_, restrictedKey, err := api.keys.Create(context.Background(), APIKey{Owner: "alice", Enabled: true, Regions: []int{14}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, _, err := api.dialWebSocket(t, wsSubprotocol, wsKeyPrefix+testAPIKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readWSMessage(t, conn)
+
+	restricted, _, err := api.dialWebSocket(t, wsSubprotocol, wsKeyPrefix+restrictedKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readWSMessage(t, restricted)
+
+	allStatesBut12 := []interface{}{}
+
+	for _, state := range snapshot.States {
+		if state.ID != 12 {
+			allStatesBut12 = append(allStatesBut12, float64(state.ID))
+		}
+	}
+
+	subscribed := func(all bool, ids ...interface{}) wsTestMessage {
+		return wsTestMessage{"subscribed", map[string]interface{}{"all": all, "ids": append([]interface{}{}, ids...)}}
+	}
+	rejected := func(ids ...interface{}) wsTestMessage {
+		return wsTestMessage{"error", map[string]interface{}{"error": "Unknown or forbidden region IDs", "ids": ids}}
+	}
+
+	tests := []struct {
+		conn    *websocket.Conn
+		command WSCommand
+		want    wsTestMessage
+	}{
+		{conn, WSCommand{Action: "subscribe", IDs: []int{12, 99999}}, rejected(float64(99999))},
+		{conn, WSCommand{Action: "subscribe", IDs: []int{12}}, subscribed(false, float64(12))},
+		{conn, WSCommand{Action: "subscribe", IDs: []int{district.ID}}, subscribed(false, float64(12), float64(district.ID))},
+		{conn, WSCommand{Action: "unsubscribe", IDs: []int{district.ID}}, subscribed(false, float64(12))},
+		{conn, WSCommand{Action: "unsubscribe"}, subscribed(false)},
+		{conn, WSCommand{Action: "subscribe"}, subscribed(true)},
+		{conn, WSCommand{Action: "unsubscribe", IDs: []int{12}}, subscribed(false, allStatesBut12...)},
+		{conn, WSCommand{Action: "dance"}, wsTestMessage{"error", map[string]interface{}{"error": "Unknown action"}}},
+		{restricted, WSCommand{Action: "subscribe", IDs: []int{12, 14}}, rejected(float64(12))},
+		{restricted, WSCommand{Action: "subscribe", IDs: []int{district.ID}}, subscribed(false, float64(district.ID))},
+	}
+
+	for _, tc := range tests {
+		if got := sendWSCommand(t, tc.conn, tc.command); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%+v: got %+v, want %+v", tc.command, got, tc.want)
+		}
+	}
+
+	// Only updates of subscribed and allowed regions are delivered.
+	for _, stateID := range []int{12, 14} {
+		update := testUpdate(int64(stateID), stateID, true, time.Now())
+		update.IsFresh = true
+		api.updates.Broadcast(update)
+	}
+
+	for _, tc := range []struct {
+		conn    *websocket.Conn
+		stateID float64
+	}{{conn, 14}, {restricted, 14}} {
+		message := readWSMessage(t, tc.conn)
+		data, _ := message.Data.(map[string]interface{})
+		state, _ := data["state"].(map[string]interface{})
+
+		if message.Event != "update" || state["id"] != tc.stateID {
+			t.Errorf("got %+v, want update of state %v", message, tc.stateID)
+		}
+	}
+}